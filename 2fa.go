@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"net/url"
 )
 
 // SubmitAccount2FAKeyValues represents the key-values for 2FA submission.
@@ -93,7 +92,7 @@ func (c *Client) SubmitAccount2FA(ctx context.Context, accountID string, req *Su
 		return fmt.Errorf("captcha must be 255 characters or less, got %d characters", len(*req.KeyValues.Captcha))
 	}
 
-	urlPath := fmt.Sprintf("link/accounts/%s/2fa.json", url.PathEscape(accountID))
+	urlPath := fmt.Sprintf("link/accounts/%s/2fa.json", escapePathSegment(accountID))
 
 	httpReq, err := c.NewRequest(ctx, http.MethodPut, urlPath, req)
 	if err != nil {
@@ -142,7 +141,7 @@ func (c *Client) GetAccountCaptcha(ctx context.Context, accountID string) (*Capt
 		return nil, fmt.Errorf("account ID is required")
 	}
 
-	urlPath := fmt.Sprintf("link/accounts/%s/captcha.json", url.PathEscape(accountID))
+	urlPath := fmt.Sprintf("link/accounts/%s/captcha.json", escapePathSegment(accountID))
 
 	httpReq, err := c.NewRequest(ctx, http.MethodGet, urlPath, nil)
 	if err != nil {
@@ -8,7 +8,6 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"strings"
-	"sync"
 	"testing"
 )
 
@@ -487,7 +486,6 @@ func TestGetAccountCaptcha(t *testing.T) {
 			config: &Config{
 				BaseURL: baseURL,
 			},
-			tokenMutex: &sync.Mutex{},
 		}
 
 		setTestToken(client, "test-token")
@@ -0,0 +1,429 @@
+package moneytree
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// InstitutionAccount is implemented by the account types that belong to a financial institution:
+// PersonalAccount, CorporateAccount, InvestmentAccount and PointAccount.
+type InstitutionAccount interface {
+	// Institution returns the institution_entity_key of the financial service the account belongs to.
+	Institution() string
+}
+
+// Institution returns the InstitutionEntityKey of the account.
+func (a PersonalAccount) Institution() string { return a.InstitutionEntityKey }
+
+// Institution returns the InstitutionEntityKey of the account.
+func (a CorporateAccount) Institution() string { return a.InstitutionEntityKey }
+
+// Institution returns the InstitutionEntityKey of the account.
+func (a InvestmentAccount) Institution() string { return a.InstitutionEntityKey }
+
+// Institution returns the InstitutionEntityKey of the account.
+func (a PointAccount) Institution() string { return a.InstitutionEntityKey }
+
+// Account is implemented by all account types: PersonalAccount, CorporateAccount,
+// InvestmentAccount and PointAccount. It allows tools to handle accounts uniformly
+// regardless of the endpoint they were retrieved from. Use a type switch to access
+// the fields specific to each type.
+type Account interface {
+	InstitutionAccount
+	// Key returns the identifier of the account. It is the account_key for personal,
+	// corporate and investment accounts, and the decimal ID for point accounts, which have no account_key.
+	Key() string
+	// Type returns the account_type of the account, e.g. "bank", "credit_card" or "point".
+	Type() string
+	// AccountBalance returns the current balance of the account, or nil if it could not be retrieved.
+	// It is named AccountBalance because the account types already have Balance or CurrentBalance fields.
+	AccountBalance() *float64
+	// Group returns the account_group of the account, the ID of the financial service registration
+	// it belongs to.
+	Group() int64
+}
+
+// Key returns the AccountKey of the account.
+func (a PersonalAccount) Key() string { return a.AccountKey }
+
+// Type returns the AccountType of the account.
+func (a PersonalAccount) Type() string { return a.AccountType }
+
+// AccountBalance returns the Balance of the account.
+func (a PersonalAccount) AccountBalance() *float64 { return a.Balance }
+
+// Group returns the AccountGroup of the account.
+func (a PersonalAccount) Group() int64 { return a.AccountGroup }
+
+// Key returns the AccountKey of the account.
+func (a CorporateAccount) Key() string { return a.AccountKey }
+
+// Type returns the AccountType of the account.
+func (a CorporateAccount) Type() string { return a.AccountType }
+
+// AccountBalance returns the CurrentBalance of the account.
+func (a CorporateAccount) AccountBalance() *float64 { return a.CurrentBalance }
+
+// Group returns the AccountGroup of the account.
+func (a CorporateAccount) Group() int64 { return a.AccountGroup }
+
+// Key returns the AccountKey of the account.
+func (a InvestmentAccount) Key() string { return a.AccountKey }
+
+// Type returns the AccountType of the account.
+func (a InvestmentAccount) Type() string { return a.AccountType }
+
+// AccountBalance returns the CurrentBalance of the account.
+func (a InvestmentAccount) AccountBalance() *float64 { return a.CurrentBalance }
+
+// Group returns the AccountGroup of the account.
+func (a InvestmentAccount) Group() int64 { return a.AccountGroup }
+
+// Key returns the ID of the point account in decimal, as point accounts have no account_key.
+func (a PointAccount) Key() string { return strconv.FormatInt(a.ID, 10) }
+
+// Type returns the AccountType of the account, which is always "point".
+func (a PointAccount) Type() string { return a.AccountType }
+
+// AccountBalance returns the CurrentBalance of the account.
+func (a PointAccount) AccountBalance() *float64 { return a.CurrentBalance }
+
+// Group returns the AccountGroup of the account.
+func (a PointAccount) Group() int64 { return a.AccountGroup }
+
+// AllAccounts retrieves every page of the personal, corporate, investment and point account lists
+// and returns all accounts as a single slice, in that order of resource types.
+// This requires the accounts_read, investment_accounts_read and points_read OAuth scopes.
+//
+// Up to WithConcurrency account lists are fetched at the same time. If any request fails,
+// the remaining requests are canceled and the error is returned, unless WithErrorPolicy
+// specifies ErrorPolicyCollect, in which case the accounts of the lists that could be
+// fetched are returned along with the errors.
+//
+// Example:
+//
+//	accounts, err := client.AllAccounts(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, account := range accounts {
+//		fmt.Printf("%s (%s): %v\n", account.Key(), account.Type(), account.AccountBalance())
+//	}
+func (c *Client) AllAccounts(ctx context.Context, opts ...AggregateOption) ([]Account, error) {
+	options := newAggregateOptions(opts)
+
+	fetchers := []func(ctx context.Context) ([]Account, error){
+		func(ctx context.Context) ([]Account, error) {
+			accounts, err := fetchAllPages(ctx, c.paginationTimeout, "link/accounts.json", url.Values{}, options.perPage(),
+				listPageFetcher(c, func(res *PersonalAccounts) []PersonalAccount { return res.Accounts }))
+			if err != nil {
+				return nil, fmt.Errorf("failed to get personal accounts: %w", err)
+			}
+			return toAccounts(accounts), nil
+		},
+		func(ctx context.Context) ([]Account, error) {
+			accounts, err := fetchAllPages(ctx, c.paginationTimeout, "link/corporate/accounts.json", url.Values{}, options.perPage(),
+				listPageFetcher(c, func(res *CorporateAccounts) []CorporateAccount { return res.Accounts }))
+			if err != nil {
+				return nil, fmt.Errorf("failed to get corporate accounts: %w", err)
+			}
+			return toAccounts(accounts), nil
+		},
+		func(ctx context.Context) ([]Account, error) {
+			accounts, err := fetchAllPages(ctx, c.paginationTimeout, "link/investments/accounts.json", url.Values{}, options.perPage(),
+				listPageFetcher(c, func(res *InvestmentAccounts) []InvestmentAccount { return res.Accounts }))
+			if err != nil {
+				return nil, fmt.Errorf("failed to get investment accounts: %w", err)
+			}
+			return toAccounts(accounts), nil
+		},
+		func(ctx context.Context) ([]Account, error) {
+			accounts, err := fetchAllPages(ctx, c.paginationTimeout, "link/points/accounts.json", url.Values{}, options.perPage(),
+				listPageFetcher(c, func(res *PointAccounts) []PointAccount { return res.PointAccounts }))
+			if err != nil {
+				return nil, fmt.Errorf("failed to get point accounts: %w", err)
+			}
+			return toAccounts(accounts), nil
+		},
+	}
+
+	perType := make([][]Account, len(fetchers))
+	err := options.run(ctx, len(fetchers), func(ctx context.Context, i int) error {
+		accounts, err := fetchers[i](ctx)
+		if err != nil {
+			return err
+		}
+		perType[i] = accounts
+		return nil
+	})
+	if err != nil && options.ErrorPolicy != ErrorPolicyCollect {
+		return nil, err
+	}
+
+	var all []Account
+	for _, accounts := range perType {
+		all = append(all, accounts...)
+	}
+	return all, err
+}
+
+// AccountsInGroup retrieves all accounts that belong to the given account_group, across the personal,
+// corporate, investment and point account lists. The accounts are returned in the order of AllAccounts.
+// This requires the same OAuth scopes as AllAccounts, and errors are handled as in AllAccounts.
+//
+// If no account belongs to the group, an empty result and a nil error are returned.
+//
+// Example:
+//
+//	accounts, err := client.AccountsInGroup(ctx, 123)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, account := range accounts {
+//		fmt.Printf("%s (%s)\n", account.Key(), account.Type())
+//	}
+func (c *Client) AccountsInGroup(ctx context.Context, accountGroup int64, opts ...AggregateOption) ([]Account, error) {
+	// With ErrorPolicyCollect, accounts holds the lists that could be fetched even if err is non-nil.
+	accounts, err := c.AllAccounts(ctx, opts...)
+	return filterAccounts(accounts, func(account Account) bool {
+		return account.Group() == accountGroup
+	}), err
+}
+
+// toAccounts converts a slice of a concrete account type to a slice of Account.
+func toAccounts[T Account](accounts []T) []Account {
+	res := make([]Account, len(accounts))
+	for i, account := range accounts {
+		res[i] = account
+	}
+	return res
+}
+
+// GroupByInstitution groups accounts of any type implementing InstitutionAccount by institution_entity_key.
+// The order of the accounts within each group is the same as in accounts.
+//
+// Example:
+//
+//	response, err := client.GetCorporateAccounts(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for institution, accounts := range moneytree.GroupByInstitution(response.Accounts) {
+//		fmt.Printf("%s: %d accounts\n", institution, len(accounts))
+//	}
+func GroupByInstitution[T InstitutionAccount](accounts []T) map[string][]T {
+	groups := make(map[string][]T)
+	for _, account := range accounts {
+		key := account.Institution()
+		groups[key] = append(groups[key], account)
+	}
+	return groups
+}
+
+// GroupAccountsByInstitution groups personal accounts by InstitutionEntityKey.
+// The order of the accounts within each group is the same as in accounts.
+// Use GroupByInstitution for corporate, investment and point accounts.
+//
+// Example:
+//
+//	response, err := client.GetPersonalAccounts(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for institution, accounts := range moneytree.GroupAccountsByInstitution(response.Accounts) {
+//		fmt.Printf("%s: %d accounts\n", institution, len(accounts))
+//	}
+func GroupAccountsByInstitution(accounts []PersonalAccount) map[string][]PersonalAccount {
+	return GroupByInstitution(accounts)
+}
+
+// defaultDateLayouts returns the layouts of the dates and date-times documented by the API.
+func defaultDateLayouts() []string {
+	return []string{time.RFC3339, time.DateOnly}
+}
+
+// ParseTime parses a date or date-time returned by the API, trying the layouts of Config.DateLayouts
+// in order, or RFC 3339 and "2006-01-02" if none are set (see WithDateLayouts).
+// The ParsedX helpers of the response types take the same layouts as an argument (see DateLayouts).
+// An empty value yields the zero time.
+//
+// Example:
+//
+//	lastAggregatedAt, err := client.ParseTime(account.LastAggregatedAt)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (c *Client) ParseTime(value string) (time.Time, error) {
+	layouts := defaultDateLayouts()
+	if c.config != nil && len(c.config.DateLayouts) > 0 {
+		layouts = c.config.DateLayouts
+	}
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return parseTime("time", value, layouts)
+}
+
+// DateLayouts returns the layouts set by Config.DateLayouts, or nil if none are set, to be passed
+// to the ParsedX helpers of the response types so that they parse dates as ParseTime does.
+//
+// Example:
+//
+//	date, err := balance.ParsedDate(client.DateLayouts()...)
+func (c *Client) DateLayouts() []string {
+	if c.config == nil {
+		return nil
+	}
+	return c.config.DateLayouts
+}
+
+// successOlderThan reports whether the last successful aggregation lastSuccess happened more than d before now.
+// A nil lastSuccess, meaning that data has never been successfully acquired, is always stale.
+func successOlderThan(lastSuccess *string, layouts []string, d time.Duration, now time.Time) (bool, error) {
+	success, ok, err := parseOptionalTime("last aggregated success", lastSuccess, layouts)
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		return true, nil
+	}
+	return now.Sub(success) > d, nil
+}
+
+// ParsedLastAggregatedAt parses LastAggregatedAt with the first of layouts that matches it,
+// or RFC 3339 and "2006-01-02" if none are given.
+// It returns the zero time if LastAggregatedAt is nil or empty.
+func (a PersonalAccount) ParsedLastAggregatedAt(layouts ...string) (time.Time, error) {
+	if a.LastAggregatedAt == nil || *a.LastAggregatedAt == "" {
+		return time.Time{}, nil
+	}
+	return parseTime("last aggregated at", *a.LastAggregatedAt, layoutsOr(layouts, defaultDateLayouts()...))
+}
+
+// ParsedLastAggregatedAt parses LastAggregatedAt with the first of layouts that matches it,
+// or RFC 3339 and "2006-01-02" if none are given.
+// It returns the zero time if LastAggregatedAt is empty.
+func (a CorporateAccount) ParsedLastAggregatedAt(layouts ...string) (time.Time, error) {
+	if a.LastAggregatedAt == "" {
+		return time.Time{}, nil
+	}
+	return parseTime("last aggregated at", a.LastAggregatedAt, layoutsOr(layouts, defaultDateLayouts()...))
+}
+
+// ParsedLastAggregatedSuccess parses LastAggregatedSuccess like ParsedLastAggregatedAt.
+// The returned bool is false if data has never been successfully acquired (LastAggregatedSuccess is nil).
+//
+// Example:
+//
+//	success, ok, err := account.ParsedLastAggregatedSuccess()
+//	if err == nil && (!ok || time.Since(success) > 24*time.Hour) {
+//		fmt.Println("account data is stale")
+//	}
+func (a CorporateAccount) ParsedLastAggregatedSuccess(layouts ...string) (time.Time, bool, error) {
+	return parseOptionalTime("last aggregated success", a.LastAggregatedSuccess, layoutsOr(layouts, defaultDateLayouts()...))
+}
+
+// SuccessOlderThan reports whether the last successful aggregation (LastAggregatedSuccess) happened
+// more than d before now, which flags stale data even when the latest aggregation attempt succeeded.
+// An account whose data has never been successfully acquired (LastAggregatedSuccess is nil) is stale.
+// An error is returned if LastAggregatedSuccess cannot be parsed.
+//
+// Example:
+//
+//	stale, err := account.SuccessOlderThan(24*time.Hour, time.Now())
+//	if err == nil && stale {
+//		fmt.Printf("account %s has not been refreshed for a day\n", account.AccountKey)
+//	}
+func (a CorporateAccount) SuccessOlderThan(d time.Duration, now time.Time) (bool, error) {
+	return successOlderThan(a.LastAggregatedSuccess, defaultDateLayouts(), d, now)
+}
+
+// ParsedLastAggregatedAt parses LastAggregatedAt with the first of layouts that matches it,
+// or RFC 3339 and "2006-01-02" if none are given.
+// It returns the zero time if LastAggregatedAt is empty.
+func (a InvestmentAccount) ParsedLastAggregatedAt(layouts ...string) (time.Time, error) {
+	if a.LastAggregatedAt == "" {
+		return time.Time{}, nil
+	}
+	return parseTime("last aggregated at", a.LastAggregatedAt, layoutsOr(layouts, defaultDateLayouts()...))
+}
+
+// ParsedLastAggregatedSuccess parses LastAggregatedSuccess like ParsedLastAggregatedAt.
+// The returned bool is false if data has never been successfully acquired (LastAggregatedSuccess is nil).
+func (a InvestmentAccount) ParsedLastAggregatedSuccess(layouts ...string) (time.Time, bool, error) {
+	return parseOptionalTime("last aggregated success", a.LastAggregatedSuccess, layoutsOr(layouts, defaultDateLayouts()...))
+}
+
+// SuccessOlderThan reports whether the last successful aggregation (LastAggregatedSuccess) happened
+// more than d before now. It behaves like CorporateAccount.SuccessOlderThan.
+func (a InvestmentAccount) SuccessOlderThan(d time.Duration, now time.Time) (bool, error) {
+	return successOlderThan(a.LastAggregatedSuccess, defaultDateLayouts(), d, now)
+}
+
+// ParsedLastAggregatedAt parses LastAggregatedAt with the first of layouts that matches it,
+// or RFC 3339 and "2006-01-02" if none are given.
+// It returns the zero time if LastAggregatedAt is empty.
+func (a PointAccount) ParsedLastAggregatedAt(layouts ...string) (time.Time, error) {
+	if a.LastAggregatedAt == "" {
+		return time.Time{}, nil
+	}
+	return parseTime("last aggregated at", a.LastAggregatedAt, layoutsOr(layouts, defaultDateLayouts()...))
+}
+
+// ParsedLastAggregatedSuccess parses LastAggregatedSuccess like ParsedLastAggregatedAt.
+// The returned bool is false if data has never been successfully acquired (LastAggregatedSuccess is nil).
+func (a PointAccount) ParsedLastAggregatedSuccess(layouts ...string) (time.Time, bool, error) {
+	return parseOptionalTime("last aggregated success", a.LastAggregatedSuccess, layoutsOr(layouts, defaultDateLayouts()...))
+}
+
+// SuccessOlderThan reports whether the last successful aggregation (LastAggregatedSuccess) happened
+// more than d before now. It behaves like CorporateAccount.SuccessOlderThan.
+func (a PointAccount) SuccessOlderThan(d time.Duration, now time.Time) (bool, error) {
+	return successOlderThan(a.LastAggregatedSuccess, defaultDateLayouts(), d, now)
+}
+
+// filterAccounts returns the accounts for which keep returns true, preserving their order.
+func filterAccounts[T any](accounts []T, keep func(T) bool) []T {
+	var filtered []T
+	for _, account := range accounts {
+		if keep(account) {
+			filtered = append(filtered, account)
+		}
+	}
+	return filtered
+}
+
+// FilterPersonalAccountsWithBalance returns the personal accounts whose Balance is not nil, preserving their order.
+// Accounts whose balance could not be retrieved, e.g. because aggregation failed, are excluded.
+//
+// Example:
+//
+//	response, err := client.GetPersonalAccounts(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	var total float64
+//	for _, account := range moneytree.FilterPersonalAccountsWithBalance(response.Accounts) {
+//		total += *account.Balance
+//	}
+func FilterPersonalAccountsWithBalance(accounts []PersonalAccount) []PersonalAccount {
+	return filterAccounts(accounts, func(a PersonalAccount) bool { return a.Balance != nil })
+}
+
+// FilterCorporateAccountsWithBalance returns the corporate accounts whose CurrentBalance is not nil, preserving their order.
+func FilterCorporateAccountsWithBalance(accounts []CorporateAccount) []CorporateAccount {
+	return filterAccounts(accounts, func(a CorporateAccount) bool { return a.CurrentBalance != nil })
+}
+
+// FilterInvestmentAccountsWithBalance returns the investment accounts whose CurrentBalance is not nil, preserving their order.
+func FilterInvestmentAccountsWithBalance(accounts []InvestmentAccount) []InvestmentAccount {
+	return filterAccounts(accounts, func(a InvestmentAccount) bool { return a.CurrentBalance != nil })
+}
+
+// FilterPointAccountsWithBalance returns the point accounts whose CurrentBalance is not nil, preserving their order.
+func FilterPointAccountsWithBalance(accounts []PointAccount) []PointAccount {
+	return filterAccounts(accounts, func(a PointAccount) bool { return a.CurrentBalance != nil })
+}
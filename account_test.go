@@ -0,0 +1,790 @@
+package moneytree
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestGroupAccountsByInstitution(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: accounts from two institutions are grouped preserving order", func(t *testing.T) {
+		t.Parallel()
+
+		accounts := []PersonalAccount{
+			{AccountKey: "a1", InstitutionEntityKey: "bank_a"},
+			{AccountKey: "b1", InstitutionEntityKey: "bank_b"},
+			{AccountKey: "a2", InstitutionEntityKey: "bank_a"},
+			{AccountKey: "b2", InstitutionEntityKey: "bank_b"},
+			{AccountKey: "a3", InstitutionEntityKey: "bank_a"},
+		}
+
+		groups := GroupAccountsByInstitution(accounts)
+
+		if len(groups) != 2 {
+			t.Fatalf("expected 2 groups, got %d", len(groups))
+		}
+		expected := map[string][]string{
+			"bank_a": {"a1", "a2", "a3"},
+			"bank_b": {"b1", "b2"},
+		}
+		for institution, keys := range expected {
+			group := groups[institution]
+			if len(group) != len(keys) {
+				t.Fatalf("expected %d accounts for %s, got %d", len(keys), institution, len(group))
+			}
+			for i, key := range keys {
+				if group[i].AccountKey != key {
+					t.Errorf("expected %s[%d].AccountKey %s, got %s", institution, i, key, group[i].AccountKey)
+				}
+			}
+		}
+	})
+
+	t.Run("success case: accounts from a single institution form one group", func(t *testing.T) {
+		t.Parallel()
+
+		accounts := []PersonalAccount{
+			{AccountKey: "a1", InstitutionEntityKey: "bank_a"},
+			{AccountKey: "a2", InstitutionEntityKey: "bank_a"},
+		}
+
+		groups := GroupAccountsByInstitution(accounts)
+
+		if len(groups) != 1 {
+			t.Fatalf("expected 1 group, got %d", len(groups))
+		}
+		if len(groups["bank_a"]) != 2 {
+			t.Errorf("expected 2 accounts for bank_a, got %d", len(groups["bank_a"]))
+		}
+	})
+
+	t.Run("success case: empty accounts return an empty map", func(t *testing.T) {
+		t.Parallel()
+
+		groups := GroupAccountsByInstitution(nil)
+
+		if len(groups) != 0 {
+			t.Errorf("expected 0 groups, got %d", len(groups))
+		}
+	})
+}
+
+func TestGroupByInstitution(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: corporate accounts are grouped by institution", func(t *testing.T) {
+		t.Parallel()
+
+		accounts := []CorporateAccount{
+			{AccountKey: "c1", InstitutionEntityKey: "bank_a"},
+			{AccountKey: "c2", InstitutionEntityKey: "bank_b"},
+		}
+
+		groups := GroupByInstitution(accounts)
+
+		if len(groups) != 2 {
+			t.Fatalf("expected 2 groups, got %d", len(groups))
+		}
+		if groups["bank_b"][0].AccountKey != "c2" {
+			t.Errorf("expected bank_b account c2, got %s", groups["bank_b"][0].AccountKey)
+		}
+	})
+
+	t.Run("success case: investment and point accounts implement InstitutionAccount", func(t *testing.T) {
+		t.Parallel()
+
+		investmentGroups := GroupByInstitution([]InvestmentAccount{{InstitutionEntityKey: "broker_a"}})
+		if len(investmentGroups["broker_a"]) != 1 {
+			t.Errorf("expected 1 account for broker_a, got %d", len(investmentGroups["broker_a"]))
+		}
+
+		pointGroups := GroupByInstitution([]PointAccount{{InstitutionEntityKey: "points_a"}})
+		if len(pointGroups["points_a"]) != 1 {
+			t.Errorf("expected 1 account for points_a, got %d", len(pointGroups["points_a"]))
+		}
+	})
+}
+
+func TestParsedLastAggregatedAt(t *testing.T) {
+	t.Parallel()
+
+	strPtr := func(v string) *string { return &v }
+	expected := time.Date(2024, time.March, 1, 9, 30, 0, 0, time.FixedZone("", 9*60*60))
+
+	t.Run("success case: RFC 3339 values are parsed for every account type", func(t *testing.T) {
+		t.Parallel()
+
+		value := "2024-03-01T09:30:00+09:00"
+		accounts := map[string]interface {
+			ParsedLastAggregatedAt(layouts ...string) (time.Time, error)
+		}{
+			"personal":   PersonalAccount{LastAggregatedAt: strPtr(value)},
+			"corporate":  CorporateAccount{LastAggregatedAt: value},
+			"investment": InvestmentAccount{LastAggregatedAt: value},
+			"point":      PointAccount{LastAggregatedAt: value},
+		}
+		for name, account := range accounts {
+			got, err := account.ParsedLastAggregatedAt()
+			if err != nil {
+				t.Fatalf("%s: expected nil, got %v", name, err)
+			}
+			if !got.Equal(expected) {
+				t.Errorf("%s: expected %v, got %v", name, expected, got)
+			}
+		}
+	})
+
+	t.Run("success case: date-only value is parsed", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := PersonalAccount{LastAggregatedAt: strPtr("2024-03-01")}.ParsedLastAggregatedAt()
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if !got.Equal(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("expected 2024-03-01, got %v", got)
+		}
+	})
+
+	t.Run("success case: nil and empty values return the zero time", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := PersonalAccount{}.ParsedLastAggregatedAt()
+		if err != nil || !got.IsZero() {
+			t.Errorf("expected zero time and nil, got %v and %v", got, err)
+		}
+		got, err = CorporateAccount{}.ParsedLastAggregatedAt()
+		if err != nil || !got.IsZero() {
+			t.Errorf("expected zero time and nil, got %v and %v", got, err)
+		}
+	})
+
+	t.Run("error case: malformed values return an error", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := (PersonalAccount{LastAggregatedAt: strPtr("yesterday")}).ParsedLastAggregatedAt(); err == nil {
+			t.Error("personal: expected error, got nil")
+		}
+		if _, err := (InvestmentAccount{LastAggregatedAt: "2024/03/01"}).ParsedLastAggregatedAt(); err == nil {
+			t.Error("investment: expected error, got nil")
+		}
+	})
+}
+
+func TestParsedLastAggregatedSuccess(t *testing.T) {
+	t.Parallel()
+
+	strPtr := func(v string) *string { return &v }
+
+	t.Run("success case: present values are parsed for every account type", func(t *testing.T) {
+		t.Parallel()
+
+		value := "2024-03-01T00:00:00Z"
+		accounts := map[string]interface {
+			ParsedLastAggregatedSuccess(layouts ...string) (time.Time, bool, error)
+		}{
+			"corporate":  CorporateAccount{LastAggregatedSuccess: strPtr(value)},
+			"investment": InvestmentAccount{LastAggregatedSuccess: strPtr(value)},
+			"point":      PointAccount{LastAggregatedSuccess: strPtr(value)},
+		}
+		for name, account := range accounts {
+			got, ok, err := account.ParsedLastAggregatedSuccess()
+			if err != nil {
+				t.Fatalf("%s: expected nil, got %v", name, err)
+			}
+			if !ok {
+				t.Errorf("%s: expected ok to be true", name)
+			}
+			if !got.Equal(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)) {
+				t.Errorf("%s: expected 2024-03-01T00:00:00Z, got %v", name, got)
+			}
+		}
+	})
+
+	t.Run("success case: nil value is reported as absent", func(t *testing.T) {
+		t.Parallel()
+
+		got, ok, err := PointAccount{}.ParsedLastAggregatedSuccess()
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if ok {
+			t.Error("expected ok to be false")
+		}
+		if !got.IsZero() {
+			t.Errorf("expected zero time, got %v", got)
+		}
+	})
+
+	t.Run("error case: malformed value returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		_, ok, err := CorporateAccount{LastAggregatedSuccess: strPtr("not a time")}.ParsedLastAggregatedSuccess()
+		if err == nil {
+			t.Error("expected error, got nil")
+		}
+		if !ok {
+			t.Error("expected ok to be true")
+		}
+	})
+}
+
+func TestSuccessOlderThan(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.March, 2, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		lastSuccess   *string
+		expectedStale bool
+		expectError   bool
+	}{
+		{name: "success case: a recent success is fresh", lastSuccess: StringPtr("2024-03-02T00:00:00Z"), expectedStale: false},
+		{name: "success case: an old success is stale", lastSuccess: StringPtr("2024-03-01T00:00:00Z"), expectedStale: true},
+		{name: "success case: a nil success is stale", lastSuccess: nil, expectedStale: true},
+		{name: "error case: a malformed success returns an error", lastSuccess: StringPtr("not a time"), expectError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			accounts := map[string]interface {
+				SuccessOlderThan(d time.Duration, now time.Time) (bool, error)
+			}{
+				"corporate":  CorporateAccount{LastAggregatedSuccess: tt.lastSuccess},
+				"investment": InvestmentAccount{LastAggregatedSuccess: tt.lastSuccess},
+				"point":      PointAccount{LastAggregatedSuccess: tt.lastSuccess},
+			}
+			for name, account := range accounts {
+				stale, err := account.SuccessOlderThan(24*time.Hour, now)
+				if tt.expectError {
+					if err == nil {
+						t.Errorf("%s: expected error, got nil", name)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatalf("%s: expected nil, got %v", name, err)
+				}
+				if stale != tt.expectedStale {
+					t.Errorf("%s: expected stale %v, got %v", name, tt.expectedStale, stale)
+				}
+			}
+		})
+	}
+}
+
+func TestFilterAccountsWithBalance(t *testing.T) {
+	t.Parallel()
+
+	floatPtr := func(v float64) *float64 { return &v }
+
+	t.Run("success case: personal accounts with nil balances are excluded", func(t *testing.T) {
+		t.Parallel()
+
+		accounts := []PersonalAccount{
+			{AccountKey: "a", Balance: floatPtr(100)},
+			{AccountKey: "b"},
+			{AccountKey: "c", Balance: floatPtr(0)},
+			{AccountKey: "d", Balance: floatPtr(-50)},
+		}
+
+		filtered := FilterPersonalAccountsWithBalance(accounts)
+
+		expectedKeys := []string{"a", "c", "d"}
+		if len(filtered) != len(expectedKeys) {
+			t.Fatalf("expected %d accounts, got %d", len(expectedKeys), len(filtered))
+		}
+		for i, key := range expectedKeys {
+			if filtered[i].AccountKey != key {
+				t.Errorf("expected filtered[%d].AccountKey %s, got %s", i, key, filtered[i].AccountKey)
+			}
+		}
+	})
+
+	t.Run("success case: corporate accounts with nil balances are excluded", func(t *testing.T) {
+		t.Parallel()
+
+		accounts := []CorporateAccount{
+			{AccountKey: "a"},
+			{AccountKey: "b", CurrentBalance: floatPtr(100)},
+		}
+
+		filtered := FilterCorporateAccountsWithBalance(accounts)
+
+		if len(filtered) != 1 || filtered[0].AccountKey != "b" {
+			t.Errorf("expected only account b, got %v", filtered)
+		}
+	})
+
+	t.Run("success case: investment accounts with nil balances are excluded", func(t *testing.T) {
+		t.Parallel()
+
+		accounts := []InvestmentAccount{
+			{AccountKey: "a", CurrentBalance: floatPtr(100)},
+			{AccountKey: "b"},
+		}
+
+		filtered := FilterInvestmentAccountsWithBalance(accounts)
+
+		if len(filtered) != 1 || filtered[0].AccountKey != "a" {
+			t.Errorf("expected only account a, got %v", filtered)
+		}
+	})
+
+	t.Run("success case: point accounts with only nil balances return an empty result", func(t *testing.T) {
+		t.Parallel()
+
+		accounts := []PointAccount{
+			{ID: 1},
+			{ID: 2},
+		}
+
+		filtered := FilterPointAccountsWithBalance(accounts)
+
+		if len(filtered) != 0 {
+			t.Errorf("expected 0 accounts, got %d", len(filtered))
+		}
+	})
+}
+
+// Compile-time checks that every account type implements Account.
+var (
+	_ Account = PersonalAccount{}
+	_ Account = CorporateAccount{}
+	_ Account = InvestmentAccount{}
+	_ Account = PointAccount{}
+)
+
+func TestAccount(t *testing.T) {
+	t.Parallel()
+
+	floatPtr := func(v float64) *float64 { return &v }
+
+	tests := []struct {
+		name            string
+		account         Account
+		expectedKey     string
+		expectedType    string
+		expectedBalance *float64
+	}{
+		{
+			name:            "personal account",
+			account:         PersonalAccount{AccountKey: "personal_key", AccountType: "bank", Balance: floatPtr(100)},
+			expectedKey:     "personal_key",
+			expectedType:    "bank",
+			expectedBalance: floatPtr(100),
+		},
+		{
+			name:            "corporate account",
+			account:         CorporateAccount{AccountKey: "corporate_key", AccountType: "credit_card", CurrentBalance: floatPtr(-50)},
+			expectedKey:     "corporate_key",
+			expectedType:    "credit_card",
+			expectedBalance: floatPtr(-50),
+		},
+		{
+			name:            "investment account",
+			account:         InvestmentAccount{AccountKey: "investment_key", AccountType: "stock"},
+			expectedKey:     "investment_key",
+			expectedType:    "stock",
+			expectedBalance: nil,
+		},
+		{
+			name:            "point account",
+			account:         PointAccount{ID: 42, AccountType: "point", CurrentBalance: floatPtr(1200)},
+			expectedKey:     "42",
+			expectedType:    "point",
+			expectedBalance: floatPtr(1200),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.account.Key(); got != tt.expectedKey {
+				t.Errorf("expected key %s, got %s", tt.expectedKey, got)
+			}
+			if got := tt.account.Type(); got != tt.expectedType {
+				t.Errorf("expected type %s, got %s", tt.expectedType, got)
+			}
+			got := tt.account.AccountBalance()
+			if (got == nil) != (tt.expectedBalance == nil) || (got != nil && *got != *tt.expectedBalance) {
+				t.Errorf("expected balance %v, got %v", tt.expectedBalance, got)
+			}
+		})
+	}
+}
+
+func TestAllAccounts(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: accounts of every resource type are merged", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/link/accounts.json":
+				_, _ = w.Write([]byte(`{"accounts": [{"account_key": "personal_1"}, {"account_key": "personal_2"}]}`))
+			case "/link/corporate/accounts.json":
+				_, _ = w.Write([]byte(`{"accounts": [{"account_key": "corporate_1"}]}`))
+			case "/link/investments/accounts.json":
+				_, _ = w.Write([]byte(`{"accounts": [{"account_key": "investment_1"}]}`))
+			case "/link/points/accounts.json":
+				_, _ = w.Write([]byte(`{"point_accounts": [{"id": 7}]}`))
+			default:
+				t.Errorf("unexpected path %s", r.URL.Path)
+			}
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		setTestToken(client, "test-access-token")
+		accounts, err := client.AllAccounts(context.Background())
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+
+		expectedKeys := []string{"personal_1", "personal_2", "corporate_1", "investment_1", "7"}
+		if len(accounts) != len(expectedKeys) {
+			t.Fatalf("expected %d accounts, got %d", len(expectedKeys), len(accounts))
+		}
+		for i, key := range expectedKeys {
+			if accounts[i].Key() != key {
+				t.Errorf("expected accounts[%d].Key() %s, got %s", i, key, accounts[i].Key())
+			}
+		}
+		if _, ok := accounts[4].(PointAccount); !ok {
+			t.Errorf("expected accounts[4] to be a PointAccount, got %T", accounts[4])
+		}
+	})
+
+	t.Run("error case: returns error when one of the lists fails", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Path == "/link/points/accounts.json" {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"error": "insufficient_scope"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"accounts": []}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		setTestToken(client, "test-access-token")
+		if _, err := client.AllAccounts(context.Background()); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
+
+func TestAccountsInGroup(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/link/accounts.json":
+			_, _ = w.Write([]byte(`{"accounts": [{"account_key": "personal_1", "account_group": 1}, {"account_key": "personal_2", "account_group": 2}]}`))
+		case "/link/corporate/accounts.json":
+			_, _ = w.Write([]byte(`{"accounts": [{"account_key": "corporate_1", "account_group": 2}]}`))
+		case "/link/investments/accounts.json":
+			_, _ = w.Write([]byte(`{"accounts": [{"account_key": "investment_1", "account_group": 1}]}`))
+		case "/link/points/accounts.json":
+			_, _ = w.Write([]byte(`{"point_accounts": [{"id": 7, "account_group": 2}]}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	baseURL, err := url.Parse(server.URL + "/")
+	if err != nil {
+		t.Fatalf("failed to parse base URL: %v", err)
+	}
+
+	client := &Client{
+		httpClient: http.DefaultClient,
+		config: &Config{
+			BaseURL: baseURL,
+		},
+	}
+	setTestToken(client, "test-access-token")
+
+	tests := []struct {
+		name         string
+		accountGroup int64
+		expectedKeys []string
+	}{
+		{name: "success case: group 1", accountGroup: 1, expectedKeys: []string{"personal_1", "investment_1"}},
+		{name: "success case: group 2", accountGroup: 2, expectedKeys: []string{"personal_2", "corporate_1", "7"}},
+		{name: "success case: unknown group", accountGroup: 3, expectedKeys: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			accounts, err := client.AccountsInGroup(context.Background(), tt.accountGroup)
+			if err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+			if len(accounts) != len(tt.expectedKeys) {
+				t.Fatalf("expected %d accounts, got %d", len(tt.expectedKeys), len(accounts))
+			}
+			for i, key := range tt.expectedKeys {
+				if accounts[i].Key() != key {
+					t.Errorf("expected accounts[%d].Key() %s, got %s", i, key, accounts[i].Key())
+				}
+				if accounts[i].Group() != tt.accountGroup {
+					t.Errorf("expected accounts[%d].Group() %d, got %d", i, tt.accountGroup, accounts[i].Group())
+				}
+			}
+		})
+	}
+}
+
+func TestClient_ParseTime(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: default layouts accept RFC 3339 and plain dates", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewClient("jp-api-staging")
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		for value, expected := range map[string]time.Time{
+			"2023-04-01T09:30:00Z": time.Date(2023, 4, 1, 9, 30, 0, 0, time.UTC),
+			"2023-04-01":           time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC),
+			"":                     {},
+		} {
+			got, err := client.ParseTime(value)
+			if err != nil {
+				t.Fatalf("%q: expected nil, got %v", value, err)
+			}
+			if !got.Equal(expected) {
+				t.Errorf("%q: expected %v, got %v", value, expected, got)
+			}
+		}
+		if _, err := client.ParseTime("2023/04/01"); err == nil {
+			t.Error("expected error for a custom layout, got nil")
+		}
+	})
+
+	t.Run("success case: custom layout matches a value the defaults reject", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewClient("jp-api-staging", WithDateLayouts(time.RFC3339, "2006/01/02"))
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		got, err := client.ParseTime("2023/04/01")
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if expected := time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC); !got.Equal(expected) {
+			t.Errorf("expected %v, got %v", expected, got)
+		}
+		// time.DateOnly was not included, so plain dates are no longer accepted.
+		if _, err := client.ParseTime("2023-04-01"); err == nil {
+			t.Error("expected error for a layout that was not configured, got nil")
+		}
+	})
+
+	t.Run("success case: ParsedX helpers use the layouts returned by DateLayouts", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewClient("jp-api-staging", WithDateLayouts(time.RFC3339, "2006/01/02"))
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+
+		balance := PersonalAccountBalance{Date: "2023/04/01"}
+		date, err := balance.ParsedDate(client.DateLayouts()...)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if expected := time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC); !date.Equal(expected) {
+			t.Errorf("expected %v, got %v", expected, date)
+		}
+
+		account := CorporateAccount{LastAggregatedAt: "2023/04/02"}
+		aggregatedAt, err := account.ParsedLastAggregatedAt(client.DateLayouts()...)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if expected := time.Date(2023, 4, 2, 0, 0, 0, 0, time.UTC); !aggregatedAt.Equal(expected) {
+			t.Errorf("expected %v, got %v", expected, aggregatedAt)
+		}
+
+		// Without layouts, the helpers keep the layout documented by the API.
+		if _, err := balance.ParsedDate(); err == nil {
+			t.Error("expected error without the custom layouts, got nil")
+		}
+		if layouts := (&Client{}).DateLayouts(); layouts != nil {
+			t.Errorf("expected nil layouts for a client without them, got %v", layouts)
+		}
+	})
+
+	t.Run("success case: client created without NewClient uses the default layouts", func(t *testing.T) {
+		t.Parallel()
+
+		client := &Client{}
+		if _, err := client.ParseTime("2023-04-01"); err != nil {
+			t.Errorf("expected nil, got %v", err)
+		}
+	})
+}
+
+func TestAllAccounts_ErrorPolicy(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/link/accounts.json":
+			_, _ = w.Write([]byte(`{"accounts": [{"account_key": "personal_1"}]}`))
+		case "/link/corporate/accounts.json":
+			_, _ = w.Write([]byte(`{"accounts": [{"account_key": "corporate_1"}]}`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error": "insufficient_scope"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	baseURL, err := url.Parse(server.URL + "/")
+	if err != nil {
+		t.Fatalf("failed to parse base URL: %v", err)
+	}
+
+	client := &Client{
+		httpClient: http.DefaultClient,
+		config: &Config{
+			BaseURL: baseURL,
+		},
+	}
+	setTestToken(client, "test-access-token")
+
+	t.Run("error case: fail fast returns no accounts", func(t *testing.T) {
+		t.Parallel()
+
+		accounts, err := client.AllAccounts(context.Background(), WithErrorPolicy(ErrorPolicyFailFast))
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if accounts != nil {
+			t.Errorf("expected nil accounts, got %v", accounts)
+		}
+	})
+
+	t.Run("error case: collect returns the accounts that could be fetched with every error", func(t *testing.T) {
+		t.Parallel()
+
+		accounts, err := client.AllAccounts(context.Background(), WithErrorPolicy(ErrorPolicyCollect))
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		for _, expected := range []string{"failed to get investment accounts", "failed to get point accounts"} {
+			if !strings.Contains(err.Error(), expected) {
+				t.Errorf("expected error to contain %q, got %v", expected, err)
+			}
+		}
+		if len(accounts) != 2 || accounts[0].Key() != "personal_1" || accounts[1].Key() != "corporate_1" {
+			t.Errorf("expected the personal and corporate accounts, got %v", accounts)
+		}
+	})
+}
+
+func TestAllAccounts_PerPage(t *testing.T) {
+	t.Parallel()
+
+	newServer := func(t *testing.T, perPages *sync.Map) *url.URL {
+		t.Helper()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			perPages.Store(r.URL.Path, r.URL.Query().Get("per_page"))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"accounts": [], "point_accounts": []}`))
+		}))
+		t.Cleanup(server.Close)
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+		return baseURL
+	}
+
+	tests := []struct {
+		name     string
+		opts     []AggregateOption
+		expected string
+	}{
+		{name: "success case: the endpoint maximum is requested by default", expected: "500"},
+		{name: "success case: WithPerPageForAggregates overrides the default", opts: []AggregateOption{WithPerPageForAggregates(50)}, expected: "50"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var perPages sync.Map
+			client := &Client{
+				httpClient: http.DefaultClient,
+				config: &Config{
+					BaseURL: newServer(t, &perPages),
+				},
+			}
+			setTestToken(client, "test-access-token")
+
+			if _, err := client.AllAccounts(context.Background(), tt.opts...); err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+
+			for _, path := range []string{"/link/accounts.json", "/link/corporate/accounts.json", "/link/investments/accounts.json", "/link/points/accounts.json"} {
+				got, ok := perPages.Load(path)
+				if !ok {
+					t.Errorf("expected a request to %s", path)
+					continue
+				}
+				if got != tt.expected {
+					t.Errorf("%s: expected per_page %s, got %v", path, tt.expected, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package moneytree
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+)
+
+// defaultConcurrency is the default number of concurrent requests issued by aggregate helpers.
+const defaultConcurrency = 4
+
+// AggregateOption configures helpers that combine the results of multiple API calls,
+// such as GetTransactionsByDateRange.
+//
+// Aggregate helpers return their results in a deterministic order that does not depend on which
+// call completes first: the results are grouped by account, or by account type for AllAccounts,
+// in the order the API lists them, and within a group in the order the API returned them.
+// Repeated calls against unchanged data therefore return identical slices, whatever the concurrency.
+type AggregateOption func(*aggregateOptions)
+
+type aggregateOptions struct {
+	Concurrency int
+	ErrorPolicy ErrorPolicy
+	// PerPage is the per_page value of paginated requests, or 0 for the endpoint maximum.
+	PerPage int
+}
+
+// ErrorPolicy specifies how an aggregate helper handles the failure of one of its API calls.
+type ErrorPolicy int
+
+const (
+	// ErrorPolicyFailFast cancels the remaining calls on the first failure and returns that error
+	// without results. This is the default.
+	ErrorPolicyFailFast ErrorPolicy = iota
+	// ErrorPolicyCollect lets every call finish and returns the results of the successful calls
+	// together with the errors.Join of all failures, so both the result and the error may be non-nil.
+	ErrorPolicyCollect
+)
+
+// newAggregateOptions applies opts on top of the default aggregate options.
+func newAggregateOptions(opts []AggregateOption) *aggregateOptions {
+	options := &aggregateOptions{
+		Concurrency: defaultConcurrency,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.Concurrency < 1 {
+		options.Concurrency = 1
+	}
+	return options
+}
+
+// WithConcurrency specifies the maximum number of API requests an aggregate helper issues concurrently.
+// The default value is 4. Values less than 1 are treated as 1.
+// Keep this value small to avoid hitting the Moneytree LINK API rate limits.
+// The concurrency does not affect the order of the results (see AggregateOption).
+func WithConcurrency(concurrency int) AggregateOption {
+	return func(opts *aggregateOptions) {
+		opts.Concurrency = concurrency
+	}
+}
+
+// WithErrorPolicy specifies how an aggregate helper handles the failure of one of its API calls.
+// The default is ErrorPolicyFailFast. With ErrorPolicyCollect, check the returned results even if
+// the error is non-nil, as they hold everything that could be retrieved.
+//
+// Example:
+//
+//	accounts, err := client.AllAccounts(ctx, moneytree.WithErrorPolicy(moneytree.ErrorPolicyCollect))
+//	if err != nil {
+//		log.Printf("some accounts could not be retrieved: %v", err)
+//	}
+//	for _, account := range accounts {
+//		fmt.Println(account.Key())
+//	}
+func WithErrorPolicy(policy ErrorPolicy) AggregateOption {
+	return func(opts *aggregateOptions) {
+		opts.ErrorPolicy = policy
+	}
+}
+
+// WithPerPageForAggregates specifies the per_page value of the paginated requests an aggregate helper issues.
+// By default, the largest value accepted by the endpoint (500) is requested to minimize round-trips.
+// A value outside the range accepted by the endpoint makes the paginated requests fail.
+func WithPerPageForAggregates(perPage int) AggregateOption {
+	return func(opts *aggregateOptions) {
+		opts.PerPage = perPage
+	}
+}
+
+// perPage returns the per_page value of paginated requests, defaulting to the endpoint maximum.
+func (o *aggregateOptions) perPage() int {
+	if o.PerPage == 0 {
+		return maxPerPage
+	}
+	return o.PerPage
+}
+
+// run calls fn for every index in [0, n) with at most Concurrency calls in flight, following ErrorPolicy.
+func (o *aggregateOptions) run(ctx context.Context, n int, fn func(ctx context.Context, i int) error) error {
+	if o.ErrorPolicy == ErrorPolicyCollect {
+		return runCollectingErrors(ctx, n, o.Concurrency, fn)
+	}
+	return runConcurrently(ctx, n, o.Concurrency, fn)
+}
+
+// runConcurrently calls fn for every index in [0, n) with at most concurrency calls in flight.
+// When a call fails, the context passed to the remaining calls is canceled
+// and the first error is returned after all started calls have finished.
+func runConcurrently(ctx context.Context, n, concurrency int, fn func(ctx context.Context, i int) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	sem := make(chan struct{}, concurrency)
+
+	for i := 0; i < n; i++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := fn(ctx, i); err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// runCollectingErrors calls fn for every index in [0, n) with at most concurrency calls in flight.
+// Unlike runConcurrently, a failing call does not cancel the others. The errors of all calls are
+// returned joined in index order, followed by the context error if the context was canceled
+// and no call already returned an error matching it (see errors.Is).
+func runCollectingErrors(ctx context.Context, n, concurrency int, fn func(ctx context.Context, i int) error) error {
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+
+	for i := 0; i < n; i++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = fn(ctx, i)
+		}(i)
+	}
+	wg.Wait()
+
+	// The calls in flight usually fail with the context error too, which is then not repeated.
+	if ctxErr := ctx.Err(); ctxErr != nil && !slices.ContainsFunc(errs, func(err error) bool { return errors.Is(err, ctxErr) }) {
+		errs = append(errs, ctxErr)
+	}
+	return errors.Join(errs...)
+}
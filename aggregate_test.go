@@ -0,0 +1,201 @@
+package moneytree
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRunConcurrently(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: every index is processed without exceeding the concurrency", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			inFlight    int32
+			maxInFlight int32
+			mu          sync.Mutex
+			processed   = map[int]bool{}
+		)
+		err := runConcurrently(context.Background(), 10, 3, func(ctx context.Context, i int) error {
+			current := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			for {
+				observed := atomic.LoadInt32(&maxInFlight)
+				if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+
+			mu.Lock()
+			processed[i] = true
+			mu.Unlock()
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+
+		if len(processed) != 10 {
+			t.Errorf("expected 10 processed indexes, got %d", len(processed))
+		}
+		if maxInFlight > 3 {
+			t.Errorf("expected at most 3 concurrent calls, got %d", maxInFlight)
+		}
+	})
+
+	t.Run("error case: the first error is returned and remaining calls are canceled", func(t *testing.T) {
+		t.Parallel()
+
+		callErr := errors.New("call failed")
+		var started int32
+		err := runConcurrently(context.Background(), 100, 1, func(ctx context.Context, i int) error {
+			atomic.AddInt32(&started, 1)
+			if i == 0 {
+				return callErr
+			}
+			return nil
+		})
+		if !errors.Is(err, callErr) {
+			t.Errorf("expected call error, got %v", err)
+		}
+		if started == 100 {
+			t.Error("expected remaining calls to be skipped after the error")
+		}
+	})
+
+	t.Run("error case: returns the context error when the context is canceled", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := runConcurrently(ctx, 3, 1, func(ctx context.Context, i int) error {
+			return nil
+		})
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	})
+}
+
+func TestWithConcurrency(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: default concurrency is applied when no option is given", func(t *testing.T) {
+		t.Parallel()
+
+		options := newAggregateOptions(nil)
+		if options.Concurrency != defaultConcurrency {
+			t.Errorf("expected concurrency %d, got %d", defaultConcurrency, options.Concurrency)
+		}
+	})
+
+	t.Run("success case: values less than 1 are treated as 1", func(t *testing.T) {
+		t.Parallel()
+
+		options := newAggregateOptions([]AggregateOption{WithConcurrency(0)})
+		if options.Concurrency != 1 {
+			t.Errorf("expected concurrency 1, got %d", options.Concurrency)
+		}
+	})
+}
+
+func TestRunCollectingErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("error case: every call runs and all errors are joined", func(t *testing.T) {
+		t.Parallel()
+
+		errOdd := errors.New("odd index")
+		var processed int32
+		err := runCollectingErrors(context.Background(), 6, 2, func(ctx context.Context, i int) error {
+			atomic.AddInt32(&processed, 1)
+			if ctx.Err() != nil {
+				t.Errorf("expected call %d not to be canceled", i)
+			}
+			if i%2 == 1 {
+				return fmt.Errorf("call %d: %w", i, errOdd)
+			}
+			return nil
+		})
+		if processed != 6 {
+			t.Errorf("expected 6 calls, got %d", processed)
+		}
+		if !errors.Is(err, errOdd) {
+			t.Fatalf("expected joined errors, got %v", err)
+		}
+		if expected := "call 1: odd index\ncall 3: odd index\ncall 5: odd index"; err.Error() != expected {
+			t.Errorf("expected errors in index order %q, got %q", expected, err.Error())
+		}
+	})
+
+	t.Run("error case: the context error is not repeated when a call returned it", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		err := runCollectingErrors(ctx, 1, 1, func(ctx context.Context, i int) error {
+			cancel()
+			return fmt.Errorf("call %d: %w", i, ctx.Err())
+		})
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+		if expected := "call 0: context canceled"; err.Error() != expected {
+			t.Errorf("expected %q, got %q", expected, err.Error())
+		}
+	})
+
+	t.Run("error case: the context error is returned when no call could start", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := runCollectingErrors(ctx, 3, 1, func(ctx context.Context, i int) error {
+			t.Errorf("expected call %d not to run", i)
+			return nil
+		})
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	})
+
+	t.Run("success case: returns nil when every call succeeds", func(t *testing.T) {
+		t.Parallel()
+
+		err := runCollectingErrors(context.Background(), 3, 2, func(ctx context.Context, i int) error {
+			return nil
+		})
+		if err != nil {
+			t.Errorf("expected nil, got %v", err)
+		}
+	})
+}
+
+func TestWithErrorPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: fail fast is the default", func(t *testing.T) {
+		t.Parallel()
+
+		if options := newAggregateOptions(nil); options.ErrorPolicy != ErrorPolicyFailFast {
+			t.Errorf("expected ErrorPolicyFailFast, got %v", options.ErrorPolicy)
+		}
+	})
+
+	t.Run("success case: collect is applied", func(t *testing.T) {
+		t.Parallel()
+
+		options := newAggregateOptions([]AggregateOption{WithErrorPolicy(ErrorPolicyCollect)})
+		if options.ErrorPolicy != ErrorPolicyCollect {
+			t.Errorf("expected ErrorPolicyCollect, got %v", options.ErrorPolicy)
+		}
+	})
+}
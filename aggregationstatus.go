@@ -0,0 +1,118 @@
+package moneytree
+
+import "strings"
+
+// AggregationStatus is the aggregation_status of an account or account group: the detailed status
+// of the latest data acquisition. The API may introduce new statuses at any time: a value this package
+// does not know is decoded and encoded again as is, so use IsKnown to detect it rather than comparing
+// with the constants only. The helpers classify unknown statuses by their dotted prefix.
+type AggregationStatus string
+
+// The aggregation statuses documented by the API.
+const (
+	AggregationStatusSuccess                      AggregationStatus = "success"
+	AggregationStatusRunningAuth                  AggregationStatus = "running.auth"
+	AggregationStatusRunningData                  AggregationStatus = "running.data"
+	AggregationStatusRunningIntelligence          AggregationStatus = "running.intelligence"
+	AggregationStatusMissingAnswerAuthSecurity    AggregationStatus = "suspended.missing-answer.auth.security"
+	AggregationStatusMissingAnswerAuthOTP         AggregationStatus = "suspended.missing-answer.auth.otp"
+	AggregationStatusMissingAnswerAuthCaptcha     AggregationStatus = "suspended.missing-answer.auth.captcha"
+	AggregationStatusMissingAnswerAuthPuzzle      AggregationStatus = "suspended.missing-answer.auth.puzzle"
+	AggregationStatusInactive                     AggregationStatus = "inactive"
+	AggregationStatusAuthCredsSecurityInvalid     AggregationStatus = "auth.creds.security.invalid"
+	AggregationStatusAuthCredsOTPInvalid          AggregationStatus = "auth.creds.otp.invalid"
+	AggregationStatusAuthCredsCaptchaInvalid      AggregationStatus = "auth.creds.captcha.invalid"
+	AggregationStatusAuthCredsPuzzleInvalid       AggregationStatus = "auth.creds.puzzle.invalid"
+	AggregationStatusAuthCredsCertificateRequired AggregationStatus = "auth.creds.certificate.required"
+	AggregationStatusGuestInterventionRequired    AggregationStatus = "guest.intervention.required"
+	AggregationStatusAuthCredsInvalid             AggregationStatus = "auth.creds.invalid"
+	AggregationStatusAuthCredsLockedTemporary     AggregationStatus = "auth.creds.locked.temporary"
+	AggregationStatusAuthCredsLockedPermanent     AggregationStatus = "auth.creds.locked.permanent"
+	AggregationStatusErrorPermanent               AggregationStatus = "error.permanent"
+	AggregationStatusErrorTemporary               AggregationStatus = "error.temporary"
+	AggregationStatusErrorSession                 AggregationStatus = "error.session"
+	AggregationStatusErrorNetwork                 AggregationStatus = "error.network"
+	AggregationStatusErrorServiceUnavailable      AggregationStatus = "error.service.unavailable"
+	AggregationStatusErrorUnsupported             AggregationStatus = "error.unsupported"
+	AggregationStatusUnknown                      AggregationStatus = "unknown"
+)
+
+// IsKnown reports whether s is one of the statuses documented by the API, i.e. one of the
+// AggregationStatus constants. It returns false for the statuses introduced after this package.
+//
+// Example:
+//
+//	if !account.AggregationStatus.IsKnown() {
+//		log.Printf("unknown aggregation status: %s", account.AggregationStatus)
+//	}
+func (s AggregationStatus) IsKnown() bool {
+	switch s {
+	case AggregationStatusSuccess, AggregationStatusRunningAuth, AggregationStatusRunningData,
+		AggregationStatusRunningIntelligence, AggregationStatusMissingAnswerAuthSecurity,
+		AggregationStatusMissingAnswerAuthOTP, AggregationStatusMissingAnswerAuthCaptcha,
+		AggregationStatusMissingAnswerAuthPuzzle, AggregationStatusInactive,
+		AggregationStatusAuthCredsSecurityInvalid, AggregationStatusAuthCredsOTPInvalid,
+		AggregationStatusAuthCredsCaptchaInvalid, AggregationStatusAuthCredsPuzzleInvalid,
+		AggregationStatusAuthCredsCertificateRequired, AggregationStatusGuestInterventionRequired,
+		AggregationStatusAuthCredsInvalid, AggregationStatusAuthCredsLockedTemporary,
+		AggregationStatusAuthCredsLockedPermanent, AggregationStatusErrorPermanent,
+		AggregationStatusErrorTemporary, AggregationStatusErrorSession, AggregationStatusErrorNetwork,
+		AggregationStatusErrorServiceUnavailable, AggregationStatusErrorUnsupported, AggregationStatusUnknown:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsRunning reports whether the data acquisition is in progress, i.e. s is a "running." status.
+func (s AggregationStatus) IsRunning() bool {
+	return strings.HasPrefix(string(s), "running.")
+}
+
+// IsSuspended reports whether the data acquisition waits for the guest to answer an additional
+// authentication challenge, i.e. s is a "suspended." status. Answer it with SubmitAccount2FA.
+func (s AggregationStatus) IsSuspended() bool {
+	return strings.HasPrefix(string(s), "suspended.")
+}
+
+// IsError reports whether the latest data acquisition failed: s is an "error." or "auth." status,
+// or "guest.intervention.required". Use IsTemporaryError and NeedsReauth to decide how to recover.
+//
+// Example:
+//
+//	switch status := account.AggregationStatus; {
+//	case status.NeedsReauth():
+//		// Ask the guest to update their credentials at the financial institution.
+//	case status.IsTemporaryError():
+//		// Retry the aggregation later.
+//	case status.IsError():
+//		// Report the failure.
+//	}
+func (s AggregationStatus) IsError() bool {
+	return strings.HasPrefix(string(s), "error.") || strings.HasPrefix(string(s), "auth.") ||
+		s == AggregationStatusGuestInterventionRequired
+}
+
+// IsTemporaryError reports whether the latest data acquisition failed for a reason expected to
+// resolve itself, so that it may succeed if retried later without any action from the guest:
+// "error.temporary", "error.session", "error.network", "error.service.unavailable" and
+// "auth.creds.locked.temporary".
+func (s AggregationStatus) IsTemporaryError() bool {
+	switch s {
+	case AggregationStatusErrorTemporary, AggregationStatusErrorSession, AggregationStatusErrorNetwork,
+		AggregationStatusErrorServiceUnavailable, AggregationStatusAuthCredsLockedTemporary:
+		return true
+	default:
+		return false
+	}
+}
+
+// NeedsReauth reports whether the guest must act on their credentials or at the financial institution
+// before the data can be acquired again: s is an "auth.creds." status other than
+// "auth.creds.locked.temporary", or "guest.intervention.required".
+func (s AggregationStatus) NeedsReauth() bool {
+	if s == AggregationStatusAuthCredsLockedTemporary {
+		return false
+	}
+	return strings.HasPrefix(string(s), "auth.creds.") || s == AggregationStatusGuestInterventionRequired
+}
@@ -0,0 +1,83 @@
+package moneytree
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAggregationStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: an unknown status is decoded and round-trips", func(t *testing.T) {
+		t.Parallel()
+
+		var account PointAccount
+		if err := json.Unmarshal([]byte(`{"aggregation_status": "error.future"}`), &account); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if account.AggregationStatus.IsKnown() {
+			t.Error("expected the status to be unknown")
+		}
+		if !account.AggregationStatus.IsError() {
+			t.Error("expected an unknown error. status to be an error")
+		}
+		data, err := json.Marshal(account.AggregationStatus)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if string(data) != `"error.future"` {
+			t.Errorf("expected \"error.future\", got %s", data)
+		}
+	})
+
+	t.Run("success case: every documented status is classified", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			status    AggregationStatus
+			running   bool
+			suspended bool
+			isError   bool
+			temporary bool
+			reauth    bool
+		}{
+			{status: AggregationStatusSuccess},
+			{status: AggregationStatusRunningAuth, running: true},
+			{status: AggregationStatusRunningData, running: true},
+			{status: AggregationStatusRunningIntelligence, running: true},
+			{status: AggregationStatusMissingAnswerAuthSecurity, suspended: true},
+			{status: AggregationStatusMissingAnswerAuthOTP, suspended: true},
+			{status: AggregationStatusMissingAnswerAuthCaptcha, suspended: true},
+			{status: AggregationStatusMissingAnswerAuthPuzzle, suspended: true},
+			{status: AggregationStatusInactive},
+			{status: AggregationStatusAuthCredsSecurityInvalid, isError: true, reauth: true},
+			{status: AggregationStatusAuthCredsOTPInvalid, isError: true, reauth: true},
+			{status: AggregationStatusAuthCredsCaptchaInvalid, isError: true, reauth: true},
+			{status: AggregationStatusAuthCredsPuzzleInvalid, isError: true, reauth: true},
+			{status: AggregationStatusAuthCredsCertificateRequired, isError: true, reauth: true},
+			{status: AggregationStatusGuestInterventionRequired, isError: true, reauth: true},
+			{status: AggregationStatusAuthCredsInvalid, isError: true, reauth: true},
+			{status: AggregationStatusAuthCredsLockedTemporary, isError: true, temporary: true},
+			{status: AggregationStatusAuthCredsLockedPermanent, isError: true, reauth: true},
+			{status: AggregationStatusErrorPermanent, isError: true},
+			{status: AggregationStatusErrorTemporary, isError: true, temporary: true},
+			{status: AggregationStatusErrorSession, isError: true, temporary: true},
+			{status: AggregationStatusErrorNetwork, isError: true, temporary: true},
+			{status: AggregationStatusErrorServiceUnavailable, isError: true, temporary: true},
+			{status: AggregationStatusErrorUnsupported, isError: true},
+			{status: AggregationStatusUnknown},
+		}
+		for _, tt := range tests {
+			s := tt.status
+			if !s.IsKnown() {
+				t.Errorf("%s: expected the status to be known", s)
+			}
+			if s.IsRunning() != tt.running || s.IsSuspended() != tt.suspended || s.IsError() != tt.isError ||
+				s.IsTemporaryError() != tt.temporary || s.NeedsReauth() != tt.reauth {
+				t.Errorf("%s: expected running=%v suspended=%v error=%v temporary=%v reauth=%v, got %v %v %v %v %v", s,
+					tt.running, tt.suspended, tt.isError, tt.temporary, tt.reauth,
+					s.IsRunning(), s.IsSuspended(), s.IsError(), s.IsTemporaryError(), s.NeedsReauth())
+			}
+		}
+	})
+}
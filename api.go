@@ -0,0 +1,87 @@
+package moneytree
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// API lists the methods of Client that call the Moneytree LINK API, so that code depending on
+// this package can accept an API and be tested with a mock or a hand-written fake instead of
+// an HTTP server. *Client implements API.
+//
+// The low-level methods NewRequest, NewFormRequest and Do, the methods that only manage the
+// Client itself such as SetToken, Close and Stats, and the Pager constructors are not listed.
+// Methods may be added to API in later versions as endpoints are supported, so a fake should
+// embed API to keep compiling.
+//
+// Example:
+//
+//	type Service struct {
+//		moneytree moneytree.API
+//	}
+//
+//	service := &Service{moneytree: client}
+type API interface {
+	// OAuth
+	RetrieveToken(ctx context.Context, req *RetrieveTokenRequest) (*OauthToken, error)
+	RevokeToken(ctx context.Context, req *RevokeTokenRequest) error
+
+	// Profile
+	GetProfile(ctx context.Context) (*Profile, error)
+	Ping(ctx context.Context) error
+	RevokeProfile(ctx context.Context) error
+	GetAccountGroups(ctx context.Context) (*AccountGroups, error)
+	RefreshProfile(ctx context.Context) error
+	RefreshAccountGroup(ctx context.Context, accountGroup int64) error
+
+	// Institutions and categories
+	GetInstitutions(ctx context.Context, opts ...GetInstitutionsOption) (*Institutions, error)
+	GetCategories(ctx context.Context, opts ...GetCategoriesOption) (*Categories, error)
+	CreateCategory(ctx context.Context, req *CreateCategoryRequest) (*Category, error)
+	GetSystemCategories(ctx context.Context, opts ...GetCategoriesOption) (*Categories, error)
+	GetCategory(ctx context.Context, categoryID int64) (*Category, error)
+	UpdateCategory(ctx context.Context, categoryID int64, req *UpdateCategoryRequest) (*Category, error)
+	DeleteCategory(ctx context.Context, categoryID int64) error
+	EnsureCategory(ctx context.Context, name string, parentID int64) (*Category, error)
+
+	// Personal accounts
+	GetPersonalAccounts(ctx context.Context, opts ...GetPersonalAccountsOption) (*PersonalAccounts, error)
+	GetPersonalAccount(ctx context.Context, accountKey string) (*PersonalAccount, error)
+	GetPersonalAccountBalances(ctx context.Context, accountID string, opts ...GetPersonalAccountBalancesOption) (*PersonalAccountBalances, error)
+	GetAccountBalanceDetails(ctx context.Context, accountID string) (*AccountBalanceDetails, error)
+	GetAccountDueBalances(ctx context.Context, accountID string, opts ...GetAccountDueBalancesOption) (*AccountDueBalances, error)
+	GetTermDeposits(ctx context.Context, accountID string, opts ...GetTermDepositsOption) (*TermDeposits, error)
+	GetPersonalAccountTransactions(ctx context.Context, accountID string, opts ...GetPersonalAccountTransactionsOption) (*PersonalAccountTransactions, error)
+	UpdatePersonalAccountTransaction(ctx context.Context, accountID string, transactionID int64, req *UpdatePersonalAccountTransactionRequest) (*PersonalAccountTransaction, error)
+	SubmitAccount2FA(ctx context.Context, accountID string, req *SubmitAccount2FARequest) error
+	GetAccountCaptcha(ctx context.Context, accountID string) (*CaptchaImage, error)
+
+	// Corporate accounts
+	GetCorporateAccounts(ctx context.Context, opts ...GetCorporateAccountsOption) (*CorporateAccounts, error)
+	GetCorporateAccountBalances(ctx context.Context, accountID string, opts ...GetCorporateAccountBalancesOption) (*CorporateAccountBalances, error)
+	GetCorporateAccountTransactions(ctx context.Context, accountID string, opts ...GetCorporateAccountTransactionsOption) (*CorporateAccountTransactions, error)
+	UpdateCorporateAccountTransaction(ctx context.Context, accountID string, transactionID int64, req *UpdateCorporateAccountTransactionRequest) (*CorporateAccountTransaction, error)
+
+	// Investment accounts
+	GetInvestmentAccounts(ctx context.Context, opts ...GetInvestmentAccountsOption) (*InvestmentAccounts, error)
+	GetInvestmentAccount(ctx context.Context, accountKey string) (*InvestmentAccount, error)
+	GetInvestmentPositions(ctx context.Context, accountID string, opts ...GetInvestmentPositionsOption) (*InvestmentPositions, error)
+	GetInvestmentAccountTransactions(ctx context.Context, accountID string, opts ...GetInvestmentAccountTransactionsOption) (*InvestmentAccountTransactions, error)
+	UpdateInvestmentAccountTransaction(ctx context.Context, accountID string, transactionID int64, req *UpdateInvestmentAccountTransactionRequest) (*InvestmentAccountTransaction, error)
+
+	// Point accounts
+	GetPointAccounts(ctx context.Context, opts ...GetPointAccountsOption) (*PointAccounts, error)
+	GetPointAccountTransactions(ctx context.Context, accountID int64, opts ...GetPointAccountTransactionsOption) (*PointAccountTransactions, error)
+	GetPointExpirations(ctx context.Context, accountID int64, opts ...GetPointExpirationsOption) (*PointExpirations, error)
+
+	// Helpers combining several requests
+	AllAccounts(ctx context.Context, opts ...AggregateOption) ([]Account, error)
+	AccountsInGroup(ctx context.Context, accountGroup int64, opts ...AggregateOption) ([]Account, error)
+	GetAllInvestmentAccountTransactions(ctx context.Context, opts ...AggregateOption) ([]InvestmentAccountTransaction, error)
+	GetTransactionsByDateRange(ctx context.Context, start, end time.Time, opts ...AggregateOption) ([]PersonalAccountTransaction, error)
+	GetBalancesForAccounts(ctx context.Context, accountKeys []string, opts ...AggregateOption) (map[string]*PersonalAccountBalances, map[string]error)
+	NetWorth(ctx context.Context) (*NetWorthSummary, error)
+	NetWorthForTokens(ctx context.Context, tokens []string, opts ...AggregateOption) (map[string]*NetWorthSummary, map[string]error)
+	ExportSnapshot(ctx context.Context, w io.Writer, opts ...ExportSnapshotOption) error
+}
@@ -0,0 +1,30 @@
+package moneytree
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAPI(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: *Client implements API", func(t *testing.T) {
+		t.Parallel()
+
+		var api API = &Client{}
+		if api == nil {
+			t.Fatal("expected a non-nil API")
+		}
+	})
+
+	t.Run("success case: every endpoint method is part of API", func(t *testing.T) {
+		t.Parallel()
+
+		apiType := reflect.TypeOf((*API)(nil)).Elem()
+		for _, endpoint := range Endpoints() {
+			if _, ok := apiType.MethodByName(endpoint.Name); !ok {
+				t.Errorf("%s is not a method of API", endpoint.Name)
+			}
+		}
+	})
+}
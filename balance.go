@@ -0,0 +1,307 @@
+package moneytree
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// balanceAsOf returns the index of the balance record closest to date on or before it, or -1 if there is none.
+// See latestBalance for how records are compared.
+func balanceAsOf[T any](balances []T, date string, key func(T) (string, int64)) (int, error) {
+	if err := validateDateFormat(date); err != nil {
+		return -1, err
+	}
+	// Dates in YYYY-MM-DD format sort chronologically as strings.
+	return latestBalance(balances, key, func(balanceDate string) bool { return balanceDate <= date }), nil
+}
+
+// latestBalance returns the index of the latest balance record whose date keep accepts, or of the latest one
+// if keep is nil, or -1 if there is none. Records are compared by their "2006-01-02" date, and records of the
+// same date by ID so that the most recently created one wins. Records with a malformed date are ignored.
+func latestBalance[T any](balances []T, key func(T) (string, int64), keep func(date string) bool) int {
+	found := -1
+	var foundDate string
+	var foundID int64
+	for i, balance := range balances {
+		balanceDate, id := key(balance)
+		if validateDateFormat(balanceDate) != nil {
+			continue
+		}
+		if keep != nil && !keep(balanceDate) {
+			continue
+		}
+		if found == -1 || balanceDate > foundDate || (balanceDate == foundDate && id > foundID) {
+			found, foundDate, foundID = i, balanceDate, id
+		}
+	}
+	return found
+}
+
+// BalanceAsOf returns the balance record of a personal account on the given date,
+// or the closest one before it if there is no record for that day.
+// This is useful for month-end reconciliation with the records returned by GetPersonalAccountBalances.
+// The balances do not need to be sorted. If several records share the selected date,
+// the one with the largest ID is returned.
+//
+// The returned bool is false if no record exists on or before date.
+// An error is returned if date is not in "2006-01-02" (YYYY-MM-DD) format.
+//
+// Example:
+//
+//	response, err := client.GetPersonalAccountBalances(ctx, "account_key_123")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	balance, ok, err := moneytree.BalanceAsOf(response.AccountBalances, "2023-03-31")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if ok && balance.Balance != nil {
+//		fmt.Printf("Balance on %s: %v\n", balance.Date, *balance.Balance)
+//	}
+func BalanceAsOf(balances []PersonalAccountBalance, date string) (PersonalAccountBalance, bool, error) {
+	i, err := balanceAsOf(balances, date, func(b PersonalAccountBalance) (string, int64) { return b.Date, b.ID })
+	if err != nil || i < 0 {
+		return PersonalAccountBalance{}, false, err
+	}
+	return balances[i], true, nil
+}
+
+// CorporateBalanceAsOf returns the balance record of a corporate account on the given date,
+// or the closest one before it. It behaves like BalanceAsOf.
+func CorporateBalanceAsOf(balances []CorporateAccountBalance, date string) (CorporateAccountBalance, bool, error) {
+	i, err := balanceAsOf(balances, date, func(b CorporateAccountBalance) (string, int64) { return b.Date, b.ID })
+	if err != nil || i < 0 {
+		return CorporateAccountBalance{}, false, err
+	}
+	return balances[i], true, nil
+}
+
+// PersonalAccountBalanceWithCurrency is a balance record of a personal account together with
+// the currency of its account, as returned by AttachCurrency.
+type PersonalAccountBalanceWithCurrency struct {
+	PersonalAccountBalance
+	// Currency is the currency code of the account the balance belongs to (e.g., "JPY", "USD").
+	// Balance is in this currency, while BalanceInBase is always in JPY.
+	// It is empty if the account has no currency.
+	Currency string
+}
+
+// AttachCurrency associates the balance records of a personal account with the currency of that account.
+// The balances endpoint does not return a currency per record, because a record is always
+// in the currency of its account; this makes it explicit for multi-currency reporting.
+// The order of balances is preserved.
+//
+// If the ID of account is known, an error is returned when a balance record belongs to
+// another account, so that a foreign-currency balance is never labeled with the wrong currency.
+//
+// Example:
+//
+//	response, err := client.GetPersonalAccountBalances(ctx, account.AccountKey)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	balances, err := moneytree.AttachCurrency(response.AccountBalances, account)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, balance := range balances {
+//		fmt.Printf("%s: %v %s\n", balance.Date, balance.Balance, balance.Currency)
+//	}
+func AttachCurrency(balances []PersonalAccountBalance, account PersonalAccount) ([]PersonalAccountBalanceWithCurrency, error) {
+	currency := ""
+	if account.Currency != nil {
+		currency = *account.Currency
+	}
+
+	res := make([]PersonalAccountBalanceWithCurrency, 0, len(balances))
+	for _, balance := range balances {
+		if account.ID != nil && balance.AccountID != *account.ID {
+			return nil, fmt.Errorf("balance %d belongs to account %d, not to account %d", balance.ID, balance.AccountID, *account.ID)
+		}
+		res = append(res, PersonalAccountBalanceWithCurrency{PersonalAccountBalance: balance, Currency: currency})
+	}
+	return res, nil
+}
+
+// MonthlyBalanceChange returns the month-over-month change of the end-of-month balance of a
+// personal account, keyed by month in "2006-01" (YYYY-MM) format.
+// The end-of-month balance is the last record of the month, by date and then by ID.
+// The balances do not need to be sorted, and records whose balance is nil are ignored.
+//
+// The change of a month is its end-of-month balance minus that of the previous month. The balance
+// is assumed unchanged over months without records: they are reported with a change of 0, and the
+// next month with records is compared with the last known balance. The first month has no previous
+// balance to compare with and is omitted, so a series covering a single month returns an empty map.
+//
+// An error is returned if a record's date is not in "2006-01-02" (YYYY-MM-DD) format.
+//
+// Example:
+//
+//	response, err := client.GetPersonalAccountBalances(ctx, "account_key_123")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	changes, err := moneytree.MonthlyBalanceChange(response.AccountBalances)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Change in March 2023: %v\n", changes["2023-03"])
+func MonthlyBalanceChange(balances []PersonalAccountBalance) (map[string]float64, error) {
+	type record struct {
+		date    time.Time
+		id      int64
+		balance float64
+	}
+	records := make([]record, 0, len(balances))
+	for _, balance := range balances {
+		date, err := time.Parse(time.DateOnly, balance.Date)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse date of balance %d: %w", balance.ID, err)
+		}
+		if balance.Balance == nil {
+			continue
+		}
+		records = append(records, record{date: date, id: balance.ID, balance: *balance.Balance})
+	}
+	slices.SortStableFunc(records, func(a, b record) int {
+		if c := a.date.Compare(b.date); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.id, b.id)
+	})
+
+	changes := make(map[string]float64)
+	if len(records) == 0 {
+		return changes, nil
+	}
+
+	// endOfMonth holds the balance of the last record of each month, in chronological order.
+	type monthBalance struct {
+		month   time.Time
+		balance float64
+	}
+	var endOfMonth []monthBalance
+	for _, r := range records {
+		month := time.Date(r.date.Year(), r.date.Month(), 1, 0, 0, 0, 0, time.UTC)
+		if n := len(endOfMonth); n > 0 && endOfMonth[n-1].month.Equal(month) {
+			endOfMonth[n-1].balance = r.balance
+			continue
+		}
+		endOfMonth = append(endOfMonth, monthBalance{month: month, balance: r.balance})
+	}
+
+	for i := 1; i < len(endOfMonth); i++ {
+		previous, current := endOfMonth[i-1], endOfMonth[i]
+		for month := previous.month.AddDate(0, 1, 0); month.Before(current.month); month = month.AddDate(0, 1, 0) {
+			changes[month.Format("2006-01")] = 0
+		}
+		changes[current.month.Format("2006-01")] = current.balance - previous.balance
+	}
+	return changes, nil
+}
+
+// BaseAmount is implemented by the balance records and accounts that report their amount converted
+// to JPY, the base currency, in addition to the amount in their own currency: PersonalAccountBalance,
+// CorporateAccountBalance, AccountBalanceDetail, CorporateAccount and InvestmentAccount.
+type BaseAmount interface {
+	// AmountInBase returns the amount converted to JPY, or nil if the API did not return it.
+	AmountInBase() *float64
+}
+
+// AmountInBase returns the BalanceInBase of the record.
+func (b PersonalAccountBalance) AmountInBase() *float64 { return &b.BalanceInBase }
+
+// AmountInBase returns the BalanceInBase of the record.
+func (b CorporateAccountBalance) AmountInBase() *float64 { return &b.BalanceInBase }
+
+// AmountInBase returns the BalanceInBase of the record.
+func (b AccountBalanceDetail) AmountInBase() *float64 { return &b.BalanceInBase }
+
+// AmountInBase returns the CurrentBalanceInBase of the account.
+func (a CorporateAccount) AmountInBase() *float64 { return a.CurrentBalanceInBase }
+
+// AmountInBase returns the CurrentBalanceInBase of the account.
+func (a InvestmentAccount) AmountInBase() *float64 { return a.CurrentBalanceInBase }
+
+// TotalInBase returns the sum of the amounts of items converted to JPY, and the number of items
+// skipped because their converted amount is nil.
+//
+// Use it instead of summing Balance or CurrentBalance when the items may be in different currencies:
+// those fields are in the currency of each account, so adding a USD balance to a JPY one gives a
+// meaningless total, while the *InBase fields are always in JPY and can be added together.
+//
+// Example:
+//
+//	response, err := client.GetCorporateAccounts(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	total, skipped := moneytree.TotalInBase(response.Accounts)
+//	fmt.Printf("Total: %v JPY (%d accounts skipped)\n", total, skipped)
+func TotalInBase[T BaseAmount](items []T) (total float64, skipped int) {
+	for _, item := range items {
+		amount := item.AmountInBase()
+		if amount == nil {
+			skipped++
+			continue
+		}
+		total += *amount
+	}
+	return total, skipped
+}
+
+// totalBalanceByCurrency sums the balances of accounts per currency code, skipping the accounts whose balance is nil.
+func totalBalanceByCurrency[T Account](accounts []T, currency func(T) string) map[string]float64 {
+	totals := make(map[string]float64)
+	for _, account := range accounts {
+		balance := account.AccountBalance()
+		if balance == nil {
+			continue
+		}
+		totals[currency(account)] += *balance
+	}
+	return totals
+}
+
+// TotalBalance returns the sum of the Balance of the accounts per currency code (e.g., "JPY", "USD"),
+// as adding balances in different currencies would give a meaningless total. Accounts whose balance is nil
+// are skipped, and accounts without a currency are summed under the empty string key. Debts such as credit
+// card balances are included as reported by the API, usually as negative values.
+// Use TotalInBase for a single total converted to JPY.
+//
+// Example:
+//
+//	response, err := client.GetPersonalAccounts(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Total: %v JPY\n", response.TotalBalance()["JPY"])
+func (r *PersonalAccounts) TotalBalance() map[string]float64 {
+	return totalBalanceByCurrency(r.Accounts, func(a PersonalAccount) string {
+		if a.Currency == nil {
+			return ""
+		}
+		return *a.Currency
+	})
+}
+
+// TotalBalance returns the sum of the CurrentBalance of the accounts per currency code.
+// See PersonalAccounts.TotalBalance.
+func (r *CorporateAccounts) TotalBalance() map[string]float64 {
+	return totalBalanceByCurrency(r.Accounts, func(a CorporateAccount) string { return a.Currency })
+}
+
+// TotalBalance returns the sum of the CurrentBalance of the accounts per currency code.
+// See PersonalAccounts.TotalBalance.
+func (r *InvestmentAccounts) TotalBalance() map[string]float64 {
+	return totalBalanceByCurrency(r.Accounts, func(a InvestmentAccount) string { return a.Currency })
+}
+
+// TotalBalance returns the sum of the CurrentBalance of the point accounts per currency code,
+// which is usually the currency representation of the point system. See PersonalAccounts.TotalBalance.
+func (r *PointAccounts) TotalBalance() map[string]float64 {
+	return totalBalanceByCurrency(r.PointAccounts, func(a PointAccount) string { return a.Currency })
+}
@@ -0,0 +1,328 @@
+package moneytree
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestBalanceAsOf(t *testing.T) {
+	t.Parallel()
+
+	floatPtr := func(v float64) *float64 { return &v }
+	balances := []PersonalAccountBalance{
+		{ID: 3, Date: "2023-04-02", Balance: floatPtr(300)},
+		{ID: 1, Date: "2023-03-15", Balance: floatPtr(100)},
+		{ID: 2, Date: "2023-03-30", Balance: floatPtr(200)},
+		{ID: 5, Date: "2023-03-30", Balance: floatPtr(250)},
+		{ID: 4, Date: "invalid", Balance: floatPtr(999)},
+	}
+
+	t.Run("success case: the record on the given date is selected", func(t *testing.T) {
+		t.Parallel()
+
+		balance, ok, err := BalanceAsOf(balances, "2023-04-02")
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if !ok {
+			t.Fatal("expected ok to be true")
+		}
+		if balance.ID != 3 {
+			t.Errorf("expected balance ID 3, got %d", balance.ID)
+		}
+	})
+
+	t.Run("success case: the closest record before the given date is selected", func(t *testing.T) {
+		t.Parallel()
+
+		balance, ok, err := BalanceAsOf(balances, "2023-03-31")
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if !ok {
+			t.Fatal("expected ok to be true")
+		}
+		// Two records share 2023-03-30; the one with the largest ID wins.
+		if balance.ID != 5 {
+			t.Errorf("expected balance ID 5, got %d", balance.ID)
+		}
+	})
+
+	t.Run("success case: no record on or before the given date", func(t *testing.T) {
+		t.Parallel()
+
+		_, ok, err := BalanceAsOf(balances, "2023-03-01")
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if ok {
+			t.Error("expected ok to be false")
+		}
+	})
+
+	t.Run("error case: returns error when date format is invalid", func(t *testing.T) {
+		t.Parallel()
+
+		if _, _, err := BalanceAsOf(balances, "2023/03/31"); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
+
+func TestCorporateBalanceAsOf(t *testing.T) {
+	t.Parallel()
+
+	balances := []CorporateAccountBalance{
+		{ID: 1, Date: "2023-03-15", Balance: 100},
+		{ID: 2, Date: "2023-04-15", Balance: 200},
+	}
+
+	t.Run("success case: the closest record before the given date is selected", func(t *testing.T) {
+		t.Parallel()
+
+		balance, ok, err := CorporateBalanceAsOf(balances, "2023-03-31")
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if !ok || balance.ID != 1 {
+			t.Errorf("expected balance ID 1, got %d (ok: %v)", balance.ID, ok)
+		}
+	})
+
+	t.Run("success case: empty balances return no record", func(t *testing.T) {
+		t.Parallel()
+
+		_, ok, err := CorporateBalanceAsOf(nil, "2023-03-31")
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if ok {
+			t.Error("expected ok to be false")
+		}
+	})
+}
+
+func TestAttachCurrency(t *testing.T) {
+	t.Parallel()
+
+	jpy := "JPY"
+	usd := "USD"
+	accountID := int64(42)
+	otherAccountID := int64(7)
+	balance := 1234.5
+
+	tests := []struct {
+		name             string
+		account          PersonalAccount
+		balances         []PersonalAccountBalance
+		expectedCurrency string
+		expectError      bool
+	}{
+		{
+			name:             "success case: JPY account",
+			account:          PersonalAccount{ID: &accountID, Currency: &jpy},
+			balances:         []PersonalAccountBalance{{ID: 1, AccountID: accountID, Date: "2023-03-30", Balance: &balance, BalanceInBase: 1234.5}, {ID: 2, AccountID: accountID, Date: "2023-03-31"}},
+			expectedCurrency: "JPY",
+		},
+		{
+			name:             "success case: foreign currency account keeps BalanceInBase in JPY",
+			account:          PersonalAccount{ID: &accountID, Currency: &usd},
+			balances:         []PersonalAccountBalance{{ID: 1, AccountID: accountID, Date: "2023-03-31", Balance: &balance, BalanceInBase: 185000}},
+			expectedCurrency: "USD",
+		},
+		{
+			name:             "success case: account without ID or currency",
+			account:          PersonalAccount{},
+			balances:         []PersonalAccountBalance{{ID: 1, AccountID: otherAccountID}},
+			expectedCurrency: "",
+		},
+		{
+			name:        "error case: balance of another account",
+			account:     PersonalAccount{ID: &accountID, Currency: &usd},
+			balances:    []PersonalAccountBalance{{ID: 1, AccountID: accountID}, {ID: 2, AccountID: otherAccountID}},
+			expectError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := AttachCurrency(tt.balances, tt.account)
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+			if len(got) != len(tt.balances) {
+				t.Fatalf("expected %d balances, got %d", len(tt.balances), len(got))
+			}
+			for i := range got {
+				if got[i].Currency != tt.expectedCurrency {
+					t.Errorf("expected currency %q, got %q", tt.expectedCurrency, got[i].Currency)
+				}
+				if got[i].ID != tt.balances[i].ID || got[i].BalanceInBase != tt.balances[i].BalanceInBase {
+					t.Errorf("expected balance %+v to be preserved, got %+v", tt.balances[i], got[i].PersonalAccountBalance)
+				}
+			}
+		})
+	}
+}
+
+func TestMonthlyBalanceChange(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: end-of-month deltas of a multi-month series", func(t *testing.T) {
+		t.Parallel()
+
+		balances := []PersonalAccountBalance{
+			{ID: 4, Date: "2023-03-31", Balance: Float64Ptr(1500)},
+			{ID: 1, Date: "2023-01-10", Balance: Float64Ptr(1000)},
+			{ID: 2, Date: "2023-01-31", Balance: Float64Ptr(1200)},
+			{ID: 3, Date: "2023-03-01", Balance: Float64Ptr(900)},
+			{ID: 6, Date: "2023-04-30", Balance: Float64Ptr(1300)},
+			{ID: 5, Date: "2023-04-30", Balance: Float64Ptr(1400)},
+			{ID: 7, Date: "2023-04-30", Balance: nil},
+		}
+
+		changes, err := MonthlyBalanceChange(balances)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+
+		// February has no records, so the balance is unchanged over it.
+		expected := map[string]float64{
+			"2023-02": 0,
+			"2023-03": 300,
+			"2023-04": -200,
+		}
+		if !maps.Equal(changes, expected) {
+			t.Errorf("expected %v, got %v", expected, changes)
+		}
+	})
+
+	t.Run("success case: a single-entry series has no change", func(t *testing.T) {
+		t.Parallel()
+
+		changes, err := MonthlyBalanceChange([]PersonalAccountBalance{{ID: 1, Date: "2023-01-10", Balance: Float64Ptr(1000)}})
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if len(changes) != 0 {
+			t.Errorf("expected no changes, got %v", changes)
+		}
+	})
+
+	t.Run("error case: returns error for a malformed date", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := MonthlyBalanceChange([]PersonalAccountBalance{{ID: 1, Date: "2023/01/10", Balance: Float64Ptr(1000)}})
+		if err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
+
+func TestTotalInBase(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: a foreign-currency account is summed in JPY", func(t *testing.T) {
+		t.Parallel()
+
+		accounts := []CorporateAccount{
+			{AccountKey: "jpy", Currency: "JPY", CurrentBalance: Float64Ptr(1000), CurrentBalanceInBase: Float64Ptr(1000)},
+			{AccountKey: "usd", Currency: "USD", CurrentBalance: Float64Ptr(10), CurrentBalanceInBase: Float64Ptr(1500)},
+			{AccountKey: "unknown", Currency: "USD", CurrentBalance: Float64Ptr(20)},
+		}
+		total, skipped := TotalInBase(accounts)
+		if total != 2500 {
+			t.Errorf("expected total 2500, got %v", total)
+		}
+		if skipped != 1 {
+			t.Errorf("expected 1 skipped account, got %d", skipped)
+		}
+	})
+
+	t.Run("success case: balance records are summed with BalanceInBase", func(t *testing.T) {
+		t.Parallel()
+
+		balances := []PersonalAccountBalance{
+			{ID: 1, Balance: Float64Ptr(10), BalanceInBase: 1500},
+			{ID: 2, Balance: nil, BalanceInBase: 300},
+		}
+		total, skipped := TotalInBase(balances)
+		if total != 1800 || skipped != 0 {
+			t.Errorf("expected 1800 and 0 skipped, got %v and %d", total, skipped)
+		}
+	})
+
+	t.Run("success case: no items", func(t *testing.T) {
+		t.Parallel()
+
+		total, skipped := TotalInBase([]InvestmentAccount(nil))
+		if total != 0 || skipped != 0 {
+			t.Errorf("expected 0 and 0 skipped, got %v and %d", total, skipped)
+		}
+	})
+}
+
+func TestTotalBalance(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: personal account balances are summed per currency", func(t *testing.T) {
+		t.Parallel()
+
+		accounts := &PersonalAccounts{
+			Accounts: []PersonalAccount{
+				{AccountKey: "bank", Currency: StringPtr("JPY"), Balance: Float64Ptr(100000)},
+				{AccountKey: "card", Currency: StringPtr("JPY"), Balance: Float64Ptr(-30000)},
+				{AccountKey: "usd", Currency: StringPtr("USD"), Balance: Float64Ptr(50)},
+				{AccountKey: "unavailable", Currency: StringPtr("JPY")},
+				{AccountKey: "no_currency", Balance: Float64Ptr(10)},
+			},
+		}
+		expected := map[string]float64{"JPY": 70000, "USD": 50, "": 10}
+		if got := accounts.TotalBalance(); !maps.Equal(got, expected) {
+			t.Errorf("expected %v, got %v", expected, got)
+		}
+	})
+
+	t.Run("success case: the other account lists are summed per currency", func(t *testing.T) {
+		t.Parallel()
+
+		corporate := &CorporateAccounts{Accounts: []CorporateAccount{
+			{Currency: "JPY", CurrentBalance: Float64Ptr(1000)},
+			{Currency: "JPY"},
+		}}
+		investment := &InvestmentAccounts{Accounts: []InvestmentAccount{
+			{Currency: "USD", CurrentBalance: Float64Ptr(20)},
+			{Currency: "USD", CurrentBalance: Float64Ptr(30)},
+		}}
+		point := &PointAccounts{PointAccounts: []PointAccount{
+			{Currency: "JPY", CurrentBalance: Float64Ptr(500)},
+		}}
+		for name, tc := range map[string]struct {
+			got      map[string]float64
+			expected map[string]float64
+		}{
+			"corporate":  {got: corporate.TotalBalance(), expected: map[string]float64{"JPY": 1000}},
+			"investment": {got: investment.TotalBalance(), expected: map[string]float64{"USD": 50}},
+			"point":      {got: point.TotalBalance(), expected: map[string]float64{"JPY": 500}},
+		} {
+			if !maps.Equal(tc.got, tc.expected) {
+				t.Errorf("%s: expected %v, got %v", name, tc.expected, tc.got)
+			}
+		}
+	})
+
+	t.Run("success case: no accounts", func(t *testing.T) {
+		t.Parallel()
+
+		if got := (&PersonalAccounts{}).TotalBalance(); len(got) != 0 {
+			t.Errorf("expected an empty map, got %v", got)
+		}
+	})
+}
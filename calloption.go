@@ -0,0 +1,120 @@
+package moneytree
+
+import "context"
+
+// CallOption configures the behavior of a single API call.
+// Call options are attached to the context passed to a Client method with WithCallOptions,
+// so they can be applied to any method without changing its signature.
+type CallOption func(*callOptions)
+
+type callOptions struct {
+	noAuth      bool
+	accessToken string
+	// maxRetries overrides RetryConfig.MaxRetries for the call when non-nil.
+	maxRetries *int
+	// noHooks suppresses the hooks of WithBeforeCall and WithAfterCall, for the calls the Client
+	// makes on its own during another call, such as the token refresh.
+	noHooks bool
+}
+
+// callOptionsKey is the context key under which call options are stored.
+type callOptionsKey struct{}
+
+// WithCallOptions returns a copy of ctx that carries the given call options.
+// Options already attached to ctx are kept, and later options override earlier ones.
+//
+// Example:
+//
+//	ctx := moneytree.WithCallOptions(ctx, moneytree.WithNoAuth())
+//	response, err := client.GetInstitutions(ctx)
+func WithCallOptions(ctx context.Context, opts ...CallOption) context.Context {
+	options := callOptionsFromContext(ctx)
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return context.WithValue(ctx, callOptionsKey{}, options)
+}
+
+// callOptionsFromContext returns the call options attached to ctx, or the zero value if none are attached.
+func callOptionsFromContext(ctx context.Context) callOptions {
+	if ctx == nil {
+		return callOptions{}
+	}
+	options, _ := ctx.Value(callOptionsKey{}).(callOptions)
+	return options
+}
+
+// WithNoAuth skips the token refresh and the Authorization header for the call.
+// Use this for endpoints that are publicly accessible in your deployment,
+// where sending a bearer token is unnecessary or rejected.
+// An Authorization header set explicitly on the request (e.g. with WithBearerToken) is left untouched.
+//
+// Example:
+//
+//	ctx := moneytree.WithCallOptions(ctx, moneytree.WithNoAuth())
+//	response, err := client.GetInstitutions(ctx)
+func WithNoAuth() CallOption {
+	return func(opts *callOptions) {
+		opts.noAuth = true
+	}
+}
+
+// WithAccessToken authenticates the call with accessToken instead of the token set on the Client.
+// The Client's token is neither refreshed nor modified, so a single Client can serve many guests
+// concurrently, each call carrying its own token. The token is sent as is: it is not refreshed
+// when it expires. An empty accessToken is ignored. WithNoAuth takes precedence over WithAccessToken.
+//
+// Example:
+//
+//	ctx := moneytree.WithCallOptions(ctx, moneytree.WithAccessToken(guestAccessToken))
+//	response, err := client.GetPersonalAccounts(ctx)
+func WithAccessToken(accessToken string) CallOption {
+	return func(opts *callOptions) {
+		opts.accessToken = accessToken
+	}
+}
+
+// WithNoRetry disables the retry of rate-limited and transient failures for the call, whatever the RetryConfig
+// of the Client. Use this for interactive calls that should fail fast rather than wait.
+//
+// Example:
+//
+//	ctx := moneytree.WithCallOptions(ctx, moneytree.WithNoRetry())
+//	profile, err := client.GetProfile(ctx)
+func WithNoRetry() CallOption {
+	return WithMaxRetries(0)
+}
+
+// WithMaxRetries overrides RetryConfig.MaxRetries for the call. The other settings of the
+// Client's RetryConfig, such as BaseDelay, still apply. A positive n enables retries for the call
+// even if RetryConfig.Enabled is false; n of zero or less disables them, like WithNoRetry.
+//
+// Example:
+//
+//	ctx := moneytree.WithCallOptions(ctx, moneytree.WithMaxRetries(10))
+//	response, err := client.GetPersonalAccountTransactions(ctx, accountKey)
+func WithMaxRetries(n int) CallOption {
+	return func(opts *callOptions) {
+		if n < 0 {
+			n = 0
+		}
+		opts.maxRetries = &n
+	}
+}
+
+// withoutHooks marks a call made by the Client on its own during another call, whose hooks already fire.
+func withoutHooks() CallOption {
+	return func(opts *callOptions) {
+		opts.noHooks = true
+	}
+}
+
+// retryConfigFor returns the retry configuration of a call: the Client's RetryConfig
+// with the overrides of the call options applied.
+func (o callOptions) retryConfigFor(config RetryConfig) RetryConfig {
+	if o.maxRetries != nil {
+		config.MaxRetries = *o.maxRetries
+		config.Enabled = *o.maxRetries > 0
+	}
+	return config
+}
@@ -0,0 +1,215 @@
+package moneytree
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithNoAuth(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: no Authorization header is sent when WithNoAuth is used", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if authHeader := r.Header.Get("Authorization"); authHeader != "" {
+				t.Errorf("expected no Authorization header, got %s", authHeader)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			if err := json.NewEncoder(w).Encode(Institutions{}); err != nil {
+				t.Errorf("failed to encode response: %v", err)
+			}
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		// No token is set: without WithNoAuth the call would fail in the token refresh path.
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		ctx := WithCallOptions(context.Background(), WithNoAuth())
+		if _, err := client.GetInstitutions(ctx); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+	})
+
+	t.Run("success case: Authorization header is sent when WithNoAuth is not used", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			expectedAuthHeader := "Bearer test-access-token"
+			if authHeader := r.Header.Get("Authorization"); authHeader != expectedAuthHeader {
+				t.Errorf("expected Authorization header %s, got %s", expectedAuthHeader, authHeader)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			if err := json.NewEncoder(w).Encode(Institutions{}); err != nil {
+				t.Errorf("failed to encode response: %v", err)
+			}
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		setTestToken(client, "test-access-token")
+		ctx := WithCallOptions(context.Background())
+		if _, err := client.GetInstitutions(ctx); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+	})
+}
+
+func TestWithCallOptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: options attached to a parent context are kept", func(t *testing.T) {
+		t.Parallel()
+
+		parent := WithCallOptions(context.Background(), WithNoAuth())
+		child := WithCallOptions(parent)
+
+		if !callOptionsFromContext(child).noAuth {
+			t.Error("expected noAuth to be inherited from the parent context")
+		}
+	})
+
+	t.Run("success case: context without options returns the zero value", func(t *testing.T) {
+		t.Parallel()
+
+		if callOptionsFromContext(context.Background()).noAuth {
+			t.Error("expected noAuth to be false")
+		}
+	})
+}
+
+func TestWithAccessToken(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: the per-call token is sent instead of the client's token", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			expectedAuthHeader := "Bearer per-call-token"
+			if authHeader := r.Header.Get("Authorization"); authHeader != expectedAuthHeader {
+				t.Errorf("expected Authorization header %s, got %s", expectedAuthHeader, authHeader)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			if err := json.NewEncoder(w).Encode(Institutions{}); err != nil {
+				t.Errorf("failed to encode response: %v", err)
+			}
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		// No token is set on the client: the per-call token must not trigger a refresh.
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		ctx := WithCallOptions(context.Background(), WithAccessToken("per-call-token"))
+		if _, err := client.GetInstitutions(ctx); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if client.token != nil {
+			t.Errorf("expected the client's token to be left unset, got %v", client.token)
+		}
+	})
+}
+
+func TestWithMaxRetries(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		clientOpts       []NewClientOption
+		callOpts         []CallOption
+		expectedRequests int
+	}{
+		{name: "success case: the client default retries 3 times", expectedRequests: 4},
+		{name: "success case: WithNoRetry sends a single request", callOpts: []CallOption{WithNoRetry()}, expectedRequests: 1},
+		{name: "success case: WithMaxRetries retries more than the default", callOpts: []CallOption{WithMaxRetries(5)}, expectedRequests: 6},
+		{
+			name:             "success case: WithMaxRetries enables retries disabled on the client",
+			clientOpts:       []NewClientOption{WithRetryConfig(RetryConfig{MaxRetries: 3, BaseDelay: time.Second, Enabled: false})},
+			callOpts:         []CallOption{WithMaxRetries(2)},
+			expectedRequests: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusTooManyRequests)
+				_, _ = w.Write([]byte(`{"error": "rate_limit_exceeded"}`))
+			}))
+			defer server.Close()
+
+			baseURL, err := url.Parse(server.URL + "/")
+			if err != nil {
+				t.Fatalf("failed to parse base URL: %v", err)
+			}
+
+			clk := newFakeClock(time.Now())
+			client, err := NewClient("jp-api-staging", append([]NewClientOption{withClockForTesting(clk)}, tt.clientOpts...)...)
+			if err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+			client.config.BaseURL = baseURL
+
+			ctx := WithCallOptions(context.Background(), append([]CallOption{WithAccessToken("test-access-token")}, tt.callOpts...)...)
+			_, err = client.GetProfile(ctx)
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusTooManyRequests {
+				t.Fatalf("expected 429 APIError, got %v", err)
+			}
+			if got := int(requests.Load()); got != tt.expectedRequests {
+				t.Errorf("expected %d requests, got %d", tt.expectedRequests, got)
+			}
+			// Retries still back off with the client's BaseDelay.
+			if got := len(clk.Sleeps()); got != tt.expectedRequests-1 {
+				t.Errorf("expected %d backoff waits, got %d", tt.expectedRequests-1, got)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package moneytree
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -120,7 +121,7 @@ func (c *Client) GetCategories(ctx context.Context, opts ...GetCategoriesOption)
 		queryParams.Set("page", fmt.Sprintf("%d", *options.Page))
 	}
 	if options.Locale != nil {
-		if *options.Locale != "en" && *options.Locale != "ja" {
+		if !c.skipClientValidation() && !isSupported(*options.Locale, SupportedLocales()) {
 			return nil, fmt.Errorf("locale must be either 'en' or 'ja', got %s", *options.Locale)
 		}
 		queryParams.Set("locale", *options.Locale)
@@ -191,6 +192,80 @@ func (c *Client) CreateCategory(ctx context.Context, req *CreateCategoryRequest)
 	return &res, nil
 }
 
+// EnsureCategory returns the guest's category with the given name and parent, creating it if it does not exist.
+// This endpoint requires the transactions_read and transactions_write OAuth scopes.
+//
+// Only user-created categories (IsSystem == false) are considered: a system category with the same
+// name does not prevent the creation. A parentID of 0 matches top-level categories.
+// Every page of the category list is searched before creating the category.
+//
+// If the creation fails with 409 Conflict or 422 Unprocessable Entity, for example because a
+// concurrent run created the same category in the meantime, the list is searched again and the
+// category created by the other run is returned. If it is still not found, the creation error is returned.
+//
+// Example:
+//
+//	category, err := client.EnsureCategory(ctx, "サブスクリプション", 0)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Category: ID=%d, Name=%s\n", category.ID, category.Name)
+func (c *Client) EnsureCategory(ctx context.Context, name string, parentID int64) (*Category, error) {
+	if name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+
+	category, err := c.findCategory(ctx, name, parentID)
+	if err != nil {
+		return nil, err
+	}
+	if category != nil {
+		return category, nil
+	}
+
+	created, createErr := c.CreateCategory(ctx, &CreateCategoryRequest{Name: name, ParentID: parentID})
+	if createErr == nil {
+		return created, nil
+	}
+	var apiErr *APIError
+	if !errors.As(createErr, &apiErr) || (apiErr.StatusCode != http.StatusConflict && apiErr.StatusCode != http.StatusUnprocessableEntity) {
+		return nil, createErr
+	}
+
+	// Another run may have created the category between the search and the creation.
+	category, err = c.findCategory(ctx, name, parentID)
+	if err != nil {
+		return nil, err
+	}
+	if category == nil {
+		return nil, createErr
+	}
+	return category, nil
+}
+
+// findCategory searches every page of the guest's categories for a user-created category
+// with the given name and parent. It returns nil if there is none.
+func (c *Client) findCategory(ctx context.Context, name string, parentID int64) (*Category, error) {
+	categories, err := fetchAllPages(ctx, c.paginationTimeout, "link/categories.json", url.Values{}, maxPerPage,
+		listPageFetcher(c, func(res *Categories) []Category { return res.Categories }))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+	for _, category := range categories {
+		if category.IsSystem || category.Name != name {
+			continue
+		}
+		var categoryParentID int64
+		if category.ParentID != nil {
+			categoryParentID = *category.ParentID
+		}
+		if categoryParentID == parentID {
+			return &category, nil
+		}
+	}
+	return nil, nil
+}
+
 // GetCategory retrieves a specific category by its ID.
 // This endpoint requires the transactions_read OAuth scope.
 //
@@ -332,7 +407,7 @@ func (c *Client) GetSystemCategories(ctx context.Context, opts ...GetCategoriesO
 		queryParams.Set("page", fmt.Sprintf("%d", *options.Page))
 	}
 	if options.Locale != nil {
-		if *options.Locale != "en" && *options.Locale != "ja" {
+		if !c.skipClientValidation() && !isSupported(*options.Locale, SupportedLocales()) {
 			return nil, fmt.Errorf("locale must be either 'en' or 'ja', got %s", *options.Locale)
 		}
 		queryParams.Set("locale", *options.Locale)
@@ -352,3 +427,39 @@ func (c *Client) GetSystemCategories(ctx context.Context, opts ...GetCategoriesO
 	}
 	return &res, nil
 }
+
+// MergeCategories merges several lists of categories, such as those returned by GetCategories and
+// GetSystemCategories, into one list with a single category per ID.
+// When an ID appears more than once, a guest category (IsSystem false) takes precedence over a system
+// category; otherwise, the first occurrence is kept. Categories are returned in the order their ID first
+// appears, and the input slices are not modified.
+//
+// Example:
+//
+//	categories, err := client.GetCategories(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	systemCategories, err := client.GetSystemCategories(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	merged := moneytree.MergeCategories(categories.Categories, systemCategories.Categories)
+func MergeCategories(sets ...[]Category) []Category {
+	var merged []Category
+	indexes := make(map[int64]int)
+	for _, set := range sets {
+		for _, category := range set {
+			i, ok := indexes[category.ID]
+			if !ok {
+				indexes[category.ID] = len(merged)
+				merged = append(merged, category)
+				continue
+			}
+			if merged[i].IsSystem && !category.IsSystem {
+				merged[i] = category
+			}
+		}
+	}
+	return merged
+}
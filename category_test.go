@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"reflect"
 	"strings"
 	"sync"
 	"testing"
@@ -19,11 +20,11 @@ func TestGetCategories(t *testing.T) {
 	t.Run("success case: categories list is retrieved correctly", func(t *testing.T) {
 		t.Parallel()
 
-		entityKey1 := stringPtr("food")
-		entityKey2 := stringPtr("transportation")
-		categoryType1 := stringPtr("expense")
-		categoryType2 := stringPtr("expense")
-		parentID1 := int64Ptr(0)
+		entityKey1 := StringPtr("food")
+		entityKey2 := StringPtr("transportation")
+		categoryType1 := StringPtr("expense")
+		categoryType2 := StringPtr("expense")
+		parentID1 := Int64Ptr(0)
 
 		expectedResponse := Categories{
 			Categories: []Category{
@@ -190,8 +191,8 @@ func TestGetCategories(t *testing.T) {
 	t.Run("success case: categories with null entity_key", func(t *testing.T) {
 		t.Parallel()
 
-		entityKey1 := stringPtr("food")
-		categoryType1 := stringPtr("expense")
+		entityKey1 := StringPtr("food")
+		categoryType1 := StringPtr("expense")
 
 		expectedResponse := Categories{
 			Categories: []Category{
@@ -344,8 +345,8 @@ func TestGetCategories(t *testing.T) {
 	t.Run("success case: categories with pagination", func(t *testing.T) {
 		t.Parallel()
 
-		entityKey1 := stringPtr("food")
-		categoryType1 := stringPtr("expense")
+		entityKey1 := StringPtr("food")
+		categoryType1 := StringPtr("expense")
 
 		expectedResponse := Categories{
 			Categories: []Category{
@@ -404,8 +405,8 @@ func TestGetCategories(t *testing.T) {
 	t.Run("success case: categories with locale", func(t *testing.T) {
 		t.Parallel()
 
-		entityKey1 := stringPtr("food")
-		categoryType1 := stringPtr("expense")
+		entityKey1 := StringPtr("food")
+		categoryType1 := StringPtr("expense")
 
 		expectedResponse := Categories{
 			Categories: []Category{
@@ -467,8 +468,8 @@ func TestGetCategories(t *testing.T) {
 	t.Run("success case: categories with pagination and locale", func(t *testing.T) {
 		t.Parallel()
 
-		entityKey1 := stringPtr("food")
-		categoryType1 := stringPtr("expense")
+		entityKey1 := StringPtr("food")
+		categoryType1 := StringPtr("expense")
 
 		expectedResponse := Categories{
 			Categories: []Category{
@@ -589,7 +590,7 @@ func TestCreateCategory(t *testing.T) {
 			EntityKey:    nil,
 			CategoryType: nil,
 			Name:         "新しいカテゴリー",
-			ParentID:     int64Ptr(0),
+			ParentID:     Int64Ptr(0),
 			IsSystem:     false,
 			CreatedAt:    "2023-01-01T00:00:00Z",
 			UpdatedAt:    "2023-01-01T00:00:00Z",
@@ -890,8 +891,8 @@ func TestGetCategory(t *testing.T) {
 		t.Parallel()
 
 		categoryID := int64(1048)
-		entityKey := stringPtr("food")
-		categoryType := stringPtr("expense")
+		entityKey := StringPtr("food")
+		categoryType := StringPtr("expense")
 
 		expectedResponse := Category{
 			ID:           categoryID,
@@ -1146,7 +1147,7 @@ func TestUpdateCategory(t *testing.T) {
 			EntityKey:    nil,
 			CategoryType: nil,
 			Name:         "更新されたカテゴリー名",
-			ParentID:     int64Ptr(0),
+			ParentID:     Int64Ptr(0),
 			IsSystem:     false,
 			CreatedAt:    "2023-01-01T00:00:00Z",
 			UpdatedAt:    "2023-01-02T00:00:00Z",
@@ -1551,7 +1552,6 @@ func TestDeleteCategory(t *testing.T) {
 			config: &Config{
 				BaseURL: baseURL,
 			},
-			tokenMutex: &sync.Mutex{},
 		}
 
 		// nolint:staticcheck // passing nil context for testing purposes
@@ -1569,11 +1569,11 @@ func TestGetSystemCategories(t *testing.T) {
 	t.Run("success case: system categories list is retrieved correctly", func(t *testing.T) {
 		t.Parallel()
 
-		entityKey1 := stringPtr("food")
-		entityKey2 := stringPtr("transportation")
-		categoryType1 := stringPtr("expense")
-		categoryType2 := stringPtr("expense")
-		parentID1 := int64Ptr(0)
+		entityKey1 := StringPtr("food")
+		entityKey2 := StringPtr("transportation")
+		categoryType1 := StringPtr("expense")
+		categoryType2 := StringPtr("expense")
+		parentID1 := Int64Ptr(0)
 
 		expectedResponse := Categories{
 			Categories: []Category{
@@ -1716,8 +1716,8 @@ func TestGetSystemCategories(t *testing.T) {
 	t.Run("success case: system categories with pagination", func(t *testing.T) {
 		t.Parallel()
 
-		entityKey1 := stringPtr("food")
-		categoryType1 := stringPtr("expense")
+		entityKey1 := StringPtr("food")
+		categoryType1 := StringPtr("expense")
 
 		expectedResponse := Categories{
 			Categories: []Category{
@@ -1779,8 +1779,8 @@ func TestGetSystemCategories(t *testing.T) {
 	t.Run("success case: system categories with locale", func(t *testing.T) {
 		t.Parallel()
 
-		entityKey1 := stringPtr("food")
-		categoryType1 := stringPtr("expense")
+		entityKey1 := StringPtr("food")
+		categoryType1 := StringPtr("expense")
 
 		expectedResponse := Categories{
 			Categories: []Category{
@@ -1973,3 +1973,207 @@ func TestGetSystemCategories(t *testing.T) {
 		}
 	})
 }
+
+func TestEnsureCategory(t *testing.T) {
+	t.Parallel()
+
+	// newCategoryServer serves the category list from categories and handles creations with create.
+	newCategoryServer := func(t *testing.T, categories *[]Category, mu *sync.Mutex, creates *int, create func(w http.ResponseWriter, req CreateCategoryRequest)) *Client {
+		t.Helper()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/link/categories.json" {
+				t.Errorf("unexpected path %s", r.URL.Path)
+			}
+			switch r.Method {
+			case http.MethodGet:
+				mu.Lock()
+				res := Categories{Categories: *categories}
+				mu.Unlock()
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(res)
+			case http.MethodPost:
+				var req CreateCategoryRequest
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					t.Errorf("failed to decode request: %v", err)
+				}
+				mu.Lock()
+				*creates++
+				mu.Unlock()
+				create(w, req)
+			default:
+				t.Errorf("unexpected method %s", r.Method)
+			}
+		}))
+		t.Cleanup(server.Close)
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+		setTestToken(client, "test-access-token")
+		return client
+	}
+
+	t.Run("success case: an existing category is returned without creating one", func(t *testing.T) {
+		t.Parallel()
+
+		var mu sync.Mutex
+		var creates int
+		categories := []Category{
+			{ID: 1, Name: "サブスクリプション", IsSystem: true},
+			{ID: 2, Name: "サブスクリプション", ParentID: Int64Ptr(10)},
+			{ID: 3, Name: "サブスクリプション", ParentID: Int64Ptr(20)},
+		}
+		client := newCategoryServer(t, &categories, &mu, &creates, func(w http.ResponseWriter, req CreateCategoryRequest) {
+			t.Error("expected no creation")
+		})
+
+		category, err := client.EnsureCategory(context.Background(), "サブスクリプション", 20)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if category.ID != 3 {
+			t.Errorf("expected category 3, got %d", category.ID)
+		}
+		if creates != 0 {
+			t.Errorf("expected no creation, got %d", creates)
+		}
+	})
+
+	t.Run("success case: an absent category is created", func(t *testing.T) {
+		t.Parallel()
+
+		var mu sync.Mutex
+		var creates int
+		// A system category with the same name is not reused.
+		categories := []Category{{ID: 1, Name: "サブスクリプション", IsSystem: true}}
+		client := newCategoryServer(t, &categories, &mu, &creates, func(w http.ResponseWriter, req CreateCategoryRequest) {
+			if req.Name != "サブスクリプション" || req.ParentID != 0 {
+				t.Errorf("unexpected request %+v", req)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id": 2, "name": "サブスクリプション", "is_system": false}`))
+		})
+
+		category, err := client.EnsureCategory(context.Background(), "サブスクリプション", 0)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if category.ID != 2 {
+			t.Errorf("expected category 2, got %d", category.ID)
+		}
+		if creates != 1 {
+			t.Errorf("expected 1 creation, got %d", creates)
+		}
+	})
+
+	t.Run("success case: the category created by a concurrent run is returned after a conflict", func(t *testing.T) {
+		t.Parallel()
+
+		var mu sync.Mutex
+		var creates int
+		var categories []Category
+		client := newCategoryServer(t, &categories, &mu, &creates, func(w http.ResponseWriter, req CreateCategoryRequest) {
+			// Another run created the category after this run listed the categories.
+			mu.Lock()
+			categories = append(categories, Category{ID: 5, Name: req.Name, ParentID: Int64Ptr(req.ParentID)})
+			mu.Unlock()
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte(`{"error": "invalid", "error_description": "name has already been taken"}`))
+		})
+
+		category, err := client.EnsureCategory(context.Background(), "サブスクリプション", 0)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if category.ID != 5 {
+			t.Errorf("expected category 5, got %d", category.ID)
+		}
+	})
+
+	t.Run("error case: the creation error is returned when the category is still absent", func(t *testing.T) {
+		t.Parallel()
+
+		var mu sync.Mutex
+		var creates int
+		var categories []Category
+		client := newCategoryServer(t, &categories, &mu, &creates, func(w http.ResponseWriter, req CreateCategoryRequest) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"error": "conflict"}`))
+		})
+
+		_, err := client.EnsureCategory(context.Background(), "サブスクリプション", 0)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusConflict {
+			t.Errorf("expected 409 APIError, got %v", err)
+		}
+	})
+
+	t.Run("error case: returns error when name is empty", func(t *testing.T) {
+		t.Parallel()
+
+		client := &Client{}
+		if _, err := client.EnsureCategory(context.Background(), "", 0); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
+
+func TestMergeCategories(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		sets     [][]Category
+		expected []Category
+	}{
+		{
+			name: "success case: overlapping sets are deduplicated by ID",
+			sets: [][]Category{
+				{{ID: 1, Name: "Food", IsSystem: true}, {ID: 100, Name: "Hobby"}},
+				{{ID: 1, Name: "Food", IsSystem: true}, {ID: 2, Name: "Transport", IsSystem: true}},
+			},
+			expected: []Category{{ID: 1, Name: "Food", IsSystem: true}, {ID: 100, Name: "Hobby"}, {ID: 2, Name: "Transport", IsSystem: true}},
+		},
+		{
+			name: "success case: a guest category takes precedence over a system category",
+			sets: [][]Category{
+				{{ID: 1, Name: "Food", IsSystem: true}},
+				{{ID: 1, Name: "My food"}},
+			},
+			expected: []Category{{ID: 1, Name: "My food"}},
+		},
+		{
+			name: "success case: the first of two guest categories is kept",
+			sets: [][]Category{
+				{{ID: 1, Name: "My food"}},
+				{{ID: 1, Name: "Food", IsSystem: true}, {ID: 1, Name: "Other food"}},
+			},
+			expected: []Category{{ID: 1, Name: "My food"}},
+		},
+		{
+			name:     "success case: no sets",
+			sets:     nil,
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := MergeCategories(tt.sets...)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+}
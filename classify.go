@@ -0,0 +1,74 @@
+package moneytree
+
+// AccountCategory is a coarse classification of accounts, derived from their account_type or
+// account_subtype, that stays stable when the API introduces new subtypes.
+type AccountCategory int
+
+const (
+	// AccountCategoryUnknown is the category of a subtype that could not be classified.
+	AccountCategoryUnknown AccountCategory = iota
+	// AccountCategoryBank is the category of deposit accounts, e.g. "bank", "savings" and "term_deposit".
+	AccountCategoryBank
+	// AccountCategoryCard is the category of payment cards, e.g. "credit_card" and "debit_card".
+	AccountCategoryCard
+	// AccountCategoryLoan is the category of loans, e.g. "card_loan" and "home_loan".
+	AccountCategoryLoan
+	// AccountCategoryStoredValue is the category of electronic money, i.e. "stored_value".
+	AccountCategoryStoredValue
+	// AccountCategoryInvestment is the category of securities and pension accounts, e.g. "stock" and "brokerage".
+	AccountCategoryInvestment
+	// AccountCategoryInsurance is the category of life insurance, i.e. "term_life" and "whole_life".
+	AccountCategoryInsurance
+	// AccountCategoryPoint is the category of point cards, i.e. "point".
+	AccountCategoryPoint
+)
+
+// DefaultSubtypeClassifier returns the category of subtype, which is either the account_type of a
+// personal or point account or the account_subtype of a corporate or investment account.
+// It knows the values documented by the API and returns AccountCategoryUnknown for any other value.
+//
+// Example:
+//
+//	category := moneytree.DefaultSubtypeClassifier(string(account.AccountSubtype))
+func DefaultSubtypeClassifier(subtype string) AccountCategory {
+	switch subtype {
+	case "bank", "savings", "checking", "chochiku", "term_deposit", "term_deposit_builder",
+		"term_deposit_shikumi", "zaikei", "tax_payment_reserve_deposit":
+		return AccountCategoryBank
+	case "credit_card", "debit_card":
+		return AccountCategoryCard
+	case "card_loan", "loan_installment", "home_loan":
+		return AccountCategoryLoan
+	case "stored_value":
+		return AccountCategoryStoredValue
+	case "stock", "brokerage", "brokerage_cash", "asset_management", "pension_cash", "defined_contribution_pension":
+		return AccountCategoryInvestment
+	case "term_life", "whole_life":
+		return AccountCategoryInsurance
+	case "point":
+		return AccountCategoryPoint
+	default:
+		return AccountCategoryUnknown
+	}
+}
+
+// ClassifySubtype returns the category of subtype with DefaultSubtypeClassifier. If the subtype is not
+// one it knows, Config.SubtypeClassifier is called instead when it is set, so that the subtypes added
+// to the API later are still classified; otherwise, AccountCategoryUnknown is returned.
+// It is the way to classify every kind of account: pass the AccountType of a personal or point account,
+// or the AccountSubtype of a corporate or investment account.
+//
+// Example:
+//
+//	for _, account := range accounts.Accounts {
+//		fmt.Printf("%s: %v\n", account.AccountKey, client.ClassifySubtype(account.AccountType))
+//	}
+func (c *Client) ClassifySubtype(subtype string) AccountCategory {
+	if category := DefaultSubtypeClassifier(subtype); category != AccountCategoryUnknown {
+		return category
+	}
+	if c.config != nil && c.config.SubtypeClassifier != nil {
+		return c.config.SubtypeClassifier(subtype)
+	}
+	return AccountCategoryUnknown
+}
@@ -0,0 +1,57 @@
+package moneytree
+
+import "testing"
+
+func TestClassifySubtype(t *testing.T) {
+	t.Parallel()
+
+	classifier := func(subtype string) AccountCategory {
+		if subtype == "car_loan" {
+			return AccountCategoryLoan
+		}
+		return AccountCategoryUnknown
+	}
+
+	tests := []struct {
+		name       string
+		subtype    string
+		classifier func(subtype string) AccountCategory
+		expected   AccountCategory
+	}{
+		{name: "success case: a personal account type", subtype: "credit_card", expected: AccountCategoryCard},
+		{name: "success case: a corporate account subtype", subtype: "term_deposit", expected: AccountCategoryBank},
+		{name: "success case: an investment account subtype", subtype: "defined_contribution_pension", expected: AccountCategoryInvestment},
+		{name: "success case: a known subtype is not passed to the classifier", subtype: "point", classifier: classifier, expected: AccountCategoryPoint},
+		{name: "success case: an unknown subtype falls through to the classifier", subtype: "car_loan", classifier: classifier, expected: AccountCategoryLoan},
+		{name: "success case: an unknown subtype without a classifier", subtype: "car_loan", expected: AccountCategoryUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &Client{config: &Config{SubtypeClassifier: tt.classifier}}
+			if got := client.ClassifySubtype(tt.subtype); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+
+	t.Run("success case: an unknown account type reaches the classifier set by WithSubtypeClassifier", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewClient("jp-api-staging", WithSubtypeClassifier(classifier))
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		for accountType, expected := range map[string]AccountCategory{
+			"credit_card": AccountCategoryCard,
+			"car_loan":    AccountCategoryLoan,
+			"crypto":      AccountCategoryUnknown,
+		} {
+			account := PersonalAccount{AccountType: accountType}
+			if got := client.ClassifySubtype(account.AccountType); got != expected {
+				t.Errorf("%s: expected %v, got %v", accountType, expected, got)
+			}
+		}
+	})
+}
@@ -0,0 +1,44 @@
+package moneytree
+
+import (
+	"context"
+	"time"
+)
+
+// clock abstracts the current time and waiting so that retry backoff and
+// token expiry can be tested deterministically with a fake clock.
+type clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// Sleep waits for d, returning early with ctx.Err() if ctx is done.
+	Sleep(ctx context.Context, d time.Duration) error
+}
+
+// realClock is the clock backed by the time package.
+type realClock struct{}
+
+// Now returns time.Now().
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// Sleep waits for d, but returns early if ctx is canceled.
+func (realClock) Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// getClock returns the clock used by c, falling back to the real clock
+// for clients created without NewClient.
+func (c *Client) getClock() clock {
+	if c.clock == nil {
+		return realClock{}
+	}
+	return c.clock
+}
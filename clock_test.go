@@ -0,0 +1,165 @@
+package moneytree
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeClock is a clock for tests. Sleep returns immediately after advancing
+// the current time by d and recording d.
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func newFakeClock(now time.Time) *fakeClock {
+	return &fakeClock{now: now}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Sleep(ctx context.Context, d time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	c.sleeps = append(c.sleeps, d)
+	return nil
+}
+
+func (c *fakeClock) Sleeps() []time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]time.Duration(nil), c.sleeps...)
+}
+
+// withClockForTesting replaces the clock of a Client created by NewClient.
+func withClockForTesting(clk clock) NewClientOption {
+	return func(c *Client) {
+		c.clock = clk
+	}
+}
+
+func TestClock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: backoff delays are waited on the injected clock", func(t *testing.T) {
+		t.Parallel()
+
+		var requestCount int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&requestCount, 1) <= 3 {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusTooManyRequests)
+				_, _ = w.Write([]byte(`{"error": "rate_limit_exceeded"}`))
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"locale_identifier": "ja_JP"}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		clk := newFakeClock(time.Now())
+		// An hour-long base delay would make the test hang if the real clock were used.
+		baseDelay := time.Hour
+		client, err := NewClient("jp-api-staging",
+			withClockForTesting(clk),
+			WithRetryConfig(RetryConfig{MaxRetries: 3, BaseDelay: baseDelay, Enabled: true}),
+		)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		client.config.BaseURL = baseURL
+		setTestToken(client, "test-access-token")
+
+		if _, err := client.GetProfile(context.Background()); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+
+		sleeps := clk.Sleeps()
+		if len(sleeps) != 3 {
+			t.Fatalf("expected 3 sleeps, got %v", sleeps)
+		}
+		for attempt, d := range sleeps {
+			exponential := baseDelay * time.Duration(1<<attempt)
+			minDelay := exponential - baseDelay
+			if minDelay < baseDelay {
+				minDelay = baseDelay
+			}
+			maxDelay := exponential + baseDelay
+			if d < minDelay || d >= maxDelay {
+				t.Errorf("expected sleep %d to be in [%v, %v), got %v", attempt, minDelay, maxDelay, d)
+			}
+		}
+	})
+
+	t.Run("success case: token expiry is evaluated on the injected clock", func(t *testing.T) {
+		t.Parallel()
+
+		var refreshed int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Path == "/oauth/token" {
+				atomic.AddInt32(&refreshed, 1)
+				_, _ = w.Write([]byte(`{"access_token": "new-access-token", "refresh_token": "new-refresh-token", "created_at": 1700000000, "expires_in": 3600}`))
+				return
+			}
+			if authHeader := r.Header.Get("Authorization"); authHeader != "Bearer new-access-token" {
+				t.Errorf("expected Authorization header %s, got %s", "Bearer new-access-token", authHeader)
+			}
+			_, _ = w.Write([]byte(`{"locale_identifier": "ja_JP"}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		// The token is valid according to the real clock, but expired according to the fake clock.
+		clk := newFakeClock(time.Now().Add(2 * time.Hour))
+		client, err := NewClient("jp-api-staging", withClockForTesting(clk))
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		client.config.BaseURL = baseURL
+		setTestToken(client, "old-access-token")
+
+		if _, err := client.GetProfile(context.Background()); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if atomic.LoadInt32(&refreshed) != 1 {
+			t.Errorf("expected the token to be refreshed once, got %d", refreshed)
+		}
+	})
+
+	t.Run("error case: real clock Sleep returns early when the context is canceled", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		if err := (realClock{}).Sleep(ctx, time.Hour); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
@@ -61,7 +61,7 @@ func (c *Client) GetAccountBalanceDetails(ctx context.Context, accountID string)
 		return nil, fmt.Errorf("account ID is required")
 	}
 
-	urlPath := fmt.Sprintf("link/accounts/%s/balances/details.json", url.PathEscape(accountID))
+	urlPath := fmt.Sprintf("link/accounts/%s/balances/details.json", escapePathSegment(accountID))
 
 	httpReq, err := c.NewRequest(ctx, http.MethodGet, urlPath, nil)
 	if err != nil {
@@ -183,31 +183,23 @@ func (c *Client) GetAccountDueBalances(ctx context.Context, accountID string, op
 		opt(options)
 	}
 
-	if options.Since != nil {
-		if err := validateDateFormat(*options.Since); err != nil {
-			return nil, err
+	if !c.skipClientValidation() {
+		if options.Since != nil {
+			if err := validateDateFormat(*options.Since); err != nil {
+				return nil, err
+			}
 		}
-	}
 
-	if options.StartDate != nil {
-		if err := validateDateFormat(*options.StartDate); err != nil {
+		if err := validateDateWindow(options.StartDate, options.EndDate); err != nil {
 			return nil, err
 		}
-		if options.EndDate == nil {
-			return nil, fmt.Errorf("end_date is required when start_date is specified")
-		}
-	}
 
-	if options.EndDate != nil {
-		if err := validateDateFormat(*options.EndDate); err != nil {
+		if err := c.validateSinceNotInFuture(options.Since); err != nil {
 			return nil, err
 		}
-		if options.StartDate == nil {
-			return nil, fmt.Errorf("start_date is required when end_date is specified")
-		}
 	}
 
-	urlPath := fmt.Sprintf("link/accounts/%s/due_balances.json", url.PathEscape(accountID))
+	urlPath := fmt.Sprintf("link/accounts/%s/due_balances.json", escapePathSegment(accountID))
 	queryParams := url.Values{}
 	if options.Page != nil {
 		queryParams.Set("page", fmt.Sprintf("%d", *options.Page))
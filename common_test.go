@@ -8,7 +8,6 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"strings"
-	"sync"
 	"testing"
 	"time"
 )
@@ -18,10 +17,6 @@ func setTestToken(client *Client, accessToken string) {
 	if accessToken == "" {
 		return
 	}
-	// Initialize tokenMutex if it's nil (for test clients created directly)
-	if client.tokenMutex == nil {
-		client.tokenMutex = &sync.Mutex{}
-	}
 	now := int(time.Now().Unix())
 	expiresIn := 3600
 	refreshToken := "test-refresh-token"
@@ -607,6 +602,30 @@ func TestGetAccountDueBalances(t *testing.T) {
 		}
 	})
 
+	t.Run("error case: returns error when start_date is after end_date", func(t *testing.T) {
+		t.Parallel()
+
+		baseURL, err := url.Parse("https://test.getmoneytree.com/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		setTestToken(client, "test-token")
+		_, err = client.GetAccountDueBalances(context.Background(), "account_key_123",
+			WithStartDateForDueBalances("2023-12-31"),
+			WithEndDateForDueBalances("2023-01-01"),
+		)
+		if err == nil || !strings.Contains(err.Error(), "start_date must be on or before end_date") {
+			t.Errorf("expected start_date after end_date error, got %v", err)
+		}
+	})
+
 	t.Run("error case: returns error when since date format is invalid", func(t *testing.T) {
 		t.Parallel()
 
@@ -1,9 +1,60 @@
 package moneytree
 
-import "net/url"
+import (
+	"net/url"
+	"time"
+)
 
 type Config struct {
 	BaseURL      *url.URL
 	ClientID     string
 	ClientSecret string
+	// SkipClientValidation disables the local validation of option values such as
+	// date formats, sort_key, sort_by and locale, so that the API is the only authority on them.
+	// This is useful when the API starts accepting values that this package does not know yet.
+	// Required arguments such as account IDs are still checked. Default is false.
+	SkipClientValidation bool
+	// DateLayouts are the time layouts tried in order by Client.ParseTime. Pass them to the ParsedX
+	// helpers of the response types with Client.DateLayouts, e.g. PersonalAccountBalance.ParsedDate.
+	// If empty, the formats the API documents are accepted: RFC 3339 date-times and "2006-01-02" dates.
+	// Set it when a deployment returns dates in a slightly different format.
+	DateLayouts []string
+	// RejectFutureSince makes methods return an error when their since option is a date after today,
+	// as such a request silently returns no records. Today is the date of the current time in its
+	// own location, which is time.Local for the real clock. Default is false.
+	// It has no effect when SkipClientValidation is set.
+	RejectFutureSince bool
+	// LogRedactor rewrites the values written by WithDebug, so that fields such as account numbers
+	// or holder names can be kept out of the logs. It is called with the canonical name of every
+	// request and response header, and with the name of every string, number or boolean member
+	// of a JSON body, and returns the value to write instead. The requests sent and the responses
+	// decoded are not affected. The Authorization header is always redacted before LogRedactor is
+	// called. If nil, only the Authorization header is redacted.
+	LogRedactor func(key, value string) string
+	// SubtypeClassifier returns the category of the account types and subtypes that
+	// DefaultSubtypeClassifier does not know, for Client.ClassifySubtype.
+	// If nil, they are classified as AccountCategoryUnknown.
+	SubtypeClassifier func(subtype string) AccountCategory
+	// ResponseValidator checks the body of every successful response once it has been decoded, e.g. to
+	// assert that every account has a currency. path is the request path, such as "/link/accounts.json".
+	// A non-nil error fails the call with that error wrapped, while the decoded value is left as is.
+	// Unlike a strict decoder, it checks business rules rather than the shape of the document.
+	// If nil, responses are not validated.
+	ResponseValidator func(path string, body []byte) error
+	// RequestLogger is called after every HTTP request sent by the Client, including each retry,
+	// with its method, URL, status code and duration. Sensitive query parameters are redacted from
+	// the URL and the Authorization header is never passed. It is called from the goroutine making
+	// the request, so it must be safe for concurrent use. If nil, requests are not logged.
+	RequestLogger func(RequestLog)
+	// DefaultPerPage is the per_page value sent by the methods that accept a per_page option,
+	// such as GetPersonalAccounts and GetPersonalAccountTransactions, when the option is not given.
+	// It must be between 1 and 500. If zero, per_page is not sent and the API default applies.
+	// Helpers that fetch every page, such as AllAccounts, are not affected.
+	DefaultPerPage int
+	// RequestTimeout bounds every call made by the Client, including the token refresh and the retries,
+	// when the context of the call has no earlier deadline: the shorter of the two always wins.
+	// It is a safety net for callers that pass a context without a deadline, such as context.Background().
+	// Helpers that make several requests, such as AllAccounts, apply it to each request.
+	// If zero, calls are only bounded by their context and the timeout of the HTTP client.
+	RequestTimeout time.Duration
 }
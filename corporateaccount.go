@@ -25,7 +25,8 @@ type CorporateAccount struct {
 	// "credit_card", "loan_installment", "asset_management", "home_loan", "stored_value",
 	// "brokerage", "brokerage_cash", "pension_cash", "defined_contribution_pension",
 	// "term_life", "whole_life".
-	AccountSubtype string `json:"account_subtype"`
+	// Values unknown to this package are kept as is; see AccountSubtype.IsKnown.
+	AccountSubtype AccountSubtype `json:"account_subtype"`
 	// AccountType describes the type of account.
 	// Deprecated: Use AccountSubtype instead, as it provides more detailed information.
 	AccountType string `json:"account_type"`
@@ -71,7 +72,7 @@ type CorporateAccount struct {
 	// "auth.creds.invalid", "auth.creds.locked.temporary", "auth.creds.locked.permanent",
 	// "error.permanent", "error.temporary", "error.session", "error.network",
 	// "error.service.unavailable", "error.unsupported", "unknown".
-	AggregationStatus string `json:"aggregation_status"`
+	AggregationStatus AggregationStatus `json:"aggregation_status"`
 	// LastAggregatedAt is the last time data was acquired for this account.
 	// Format: ISO 8601 date-time.
 	LastAggregatedAt string `json:"last_aggregated_at"`
@@ -219,9 +220,11 @@ type GetCorporateAccountBalancesOption func(*getCorporateAccountBalancesOptions)
 
 type getCorporateAccountBalancesOptions struct {
 	paginationOptions
-	SortKey *string
-	SortBy  *string
-	Since   *string
+	SortKey   *string
+	SortBy    *string
+	Since     *string
+	StartDate *string
+	EndDate   *string
 }
 
 // WithPageForCorporateBalances specifies the page number for pagination.
@@ -246,7 +249,7 @@ func WithPerPageForCorporateBalances(perPage int) GetCorporateAccountBalancesOpt
 // Using sort_key may affect response time, so it is recommended to use it only when necessary.
 // If "date" is specified as the sort key, the database sorts by the balance date
 // (which is the actual balance date, not the date Moneytree obtained it).
-// The default value is "id".
+// The default value is "id". The possible values are listed by SupportedBalanceSortKeys.
 func WithSortKeyForCorporateBalances(sortKey string) GetCorporateAccountBalancesOption {
 	return func(opts *getCorporateAccountBalancesOptions) {
 		opts.SortKey = &sortKey
@@ -272,6 +275,24 @@ func WithSinceForCorporateBalances(since string) GetCorporateAccountBalancesOpti
 	}
 }
 
+// WithStartDateForCorporateBalances specifies the first date of the balance records to retrieve (start_date).
+// If specified, end_date is also required, and start_date must be on or before end_date.
+// Date format: "2006-01-02" (YYYY-MM-DD).
+func WithStartDateForCorporateBalances(startDate string) GetCorporateAccountBalancesOption {
+	return func(opts *getCorporateAccountBalancesOptions) {
+		opts.StartDate = &startDate
+	}
+}
+
+// WithEndDateForCorporateBalances specifies the last date of the balance records to retrieve (end_date).
+// If specified, start_date is also required.
+// Date format: "2006-01-02" (YYYY-MM-DD).
+func WithEndDateForCorporateBalances(endDate string) GetCorporateAccountBalancesOption {
+	return func(opts *getCorporateAccountBalancesOptions) {
+		opts.EndDate = &endDate
+	}
+}
+
 // GetCorporateAccountBalances retrieves the balance history for a specific corporate account.
 // This endpoint requires the accounts_read OAuth scope.
 //
@@ -304,6 +325,13 @@ func WithSinceForCorporateBalances(since string) GetCorporateAccountBalancesOpti
 //		moneytree.WithSinceForCorporateBalances("2023-01-01"),
 //	)
 //
+// Example with a date window:
+//
+//	response, err := client.GetCorporateAccountBalances(ctx, accessToken, "account_key_123",
+//		moneytree.WithStartDateForCorporateBalances("2023-01-01"),
+//		moneytree.WithEndDateForCorporateBalances("2023-01-31"),
+//	)
+//
 // Reference: https://docs.link.getmoneytree.com/reference/get-link-corporate-account-balances
 func (c *Client) GetCorporateAccountBalances(ctx context.Context, accountID string, opts ...GetCorporateAccountBalancesOption) (*CorporateAccountBalances, error) {
 	if accountID == "" {
@@ -315,21 +343,37 @@ func (c *Client) GetCorporateAccountBalances(ctx context.Context, accountID stri
 		opt(options)
 	}
 
-	if options.Since != nil {
-		if err := validateDateFormat(*options.Since); err != nil {
+	if !c.skipClientValidation() {
+		if options.Since != nil {
+			if err := validateDateFormat(*options.Since); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := validateDateWindow(options.StartDate, options.EndDate); err != nil {
 			return nil, err
 		}
-	}
 
-	if options.SortBy != nil {
-		if *options.SortBy != "asc" && *options.SortBy != "desc" {
-			return nil, fmt.Errorf("sort_by must be 'asc' or 'desc', got: %s", *options.SortBy)
+		if err := validateSortKey(options.SortKey, SupportedBalanceSortKeys()); err != nil {
+			return nil, err
+		}
+
+		if options.SortBy != nil {
+			if !isSupported(*options.SortBy, SupportedSortBy()) {
+				return nil, fmt.Errorf("sort_by must be 'asc' or 'desc', got: %s", *options.SortBy)
+			}
+		}
+
+		if err := c.validateSinceNotInFuture(options.Since); err != nil {
+			return nil, err
 		}
 	}
 
-	urlPath := fmt.Sprintf("link/corporate/accounts/%s/balances.json", url.PathEscape(accountID))
+	urlPath := fmt.Sprintf("link/corporate/accounts/%s/balances.json", escapePathSegment(accountID))
 	queryParams := url.Values{}
-	applyPaginationParams(queryParams, &options.paginationOptions)
+	if err := c.applyPagination(queryParams, &options.paginationOptions); err != nil {
+		return nil, err
+	}
 	if options.SortKey != nil {
 		queryParams.Set("sort_key", *options.SortKey)
 	}
@@ -339,6 +383,12 @@ func (c *Client) GetCorporateAccountBalances(ctx context.Context, accountID stri
 	if options.Since != nil {
 		queryParams.Set("since", *options.Since)
 	}
+	if options.StartDate != nil {
+		queryParams.Set("start_date", *options.StartDate)
+	}
+	if options.EndDate != nil {
+		queryParams.Set("end_date", *options.EndDate)
+	}
 	if len(queryParams) > 0 {
 		urlPath = fmt.Sprintf("%s?%s", urlPath, queryParams.Encode())
 	}
@@ -470,7 +520,7 @@ func WithPerPageForCorporateTransactions(perPage int) GetCorporateAccountTransac
 // Using sort_key may affect response time, so it is recommended to use it only when necessary.
 // If "date" is specified as the sort key, the database sorts by the transaction date
 // (which is the actual transaction date, not the date Moneytree obtained it).
-// The default value is "id".
+// The default value is "id". The possible values are listed by SupportedTransactionSortKeys.
 func WithSortKeyForCorporateTransactions(sortKey string) GetCorporateAccountTransactionsOption {
 	return func(opts *getCorporateTransactionsOptions) {
 		opts.SortKey = &sortKey
@@ -495,6 +545,22 @@ func WithSinceForCorporateTransactions(since string) GetCorporateAccountTransact
 	}
 }
 
+// validate returns all problems with the options, joined with errors.Join.
+func (o *getCorporateTransactionsOptions) validate() error {
+	return validateListOptions(&o.paginationOptions, o.SortKey, o.SortBy, o.Since)
+}
+
+// ValidateCorporateAccountTransactionsOptions validates opts as GetCorporateAccountTransactions does,
+// without sending a request. Like ValidatePersonalAccountTransactionsOptions, it reports every
+// invalid option at once in an error joined with errors.Join.
+func ValidateCorporateAccountTransactionsOptions(opts ...GetCorporateAccountTransactionsOption) error {
+	options := &getCorporateTransactionsOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return options.validate()
+}
+
 // GetCorporateAccountTransactions retrieves the transaction records for a specific corporate account.
 // This endpoint requires the transactions_read OAuth scope.
 //
@@ -537,21 +603,21 @@ func (c *Client) GetCorporateAccountTransactions(ctx context.Context, accountID
 		opt(options)
 	}
 
-	if options.Since != nil {
-		if err := validateDateFormat(*options.Since); err != nil {
+	if !c.skipClientValidation() {
+		if err := options.validate(); err != nil {
 			return nil, err
 		}
-	}
 
-	if options.SortBy != nil {
-		if *options.SortBy != "asc" && *options.SortBy != "desc" {
-			return nil, fmt.Errorf("sort_by must be 'asc' or 'desc', got: %s", *options.SortBy)
+		if err := c.validateSinceNotInFuture(options.Since); err != nil {
+			return nil, err
 		}
 	}
 
-	urlPath := fmt.Sprintf("link/corporate/accounts/%s/transactions.json", url.PathEscape(accountID))
+	urlPath := fmt.Sprintf("link/corporate/accounts/%s/transactions.json", escapePathSegment(accountID))
 	queryParams := url.Values{}
-	applyPaginationParams(queryParams, &options.paginationOptions)
+	if err := c.applyPagination(queryParams, &options.paginationOptions); err != nil {
+		return nil, err
+	}
 	if options.SortKey != nil {
 		queryParams.Set("sort_key", *options.SortKey)
 	}
@@ -637,7 +703,7 @@ func (c *Client) UpdateCorporateAccountTransaction(ctx context.Context, accountI
 		return nil, fmt.Errorf("description_guest must be 255 characters or less, got %d characters", len(*req.DescriptionGuest))
 	}
 
-	urlPath := fmt.Sprintf("link/corporate/accounts/%s/transactions/%d.json", url.PathEscape(accountID), transactionID)
+	urlPath := fmt.Sprintf("link/corporate/accounts/%s/transactions/%d.json", escapePathSegment(accountID), transactionID)
 
 	httpReq, err := c.NewRequest(ctx, http.MethodPut, urlPath, req)
 	if err != nil {
@@ -20,8 +20,8 @@ func TestGetCorporateAccounts(t *testing.T) {
 
 		id1 := int64(123)
 		id2 := int64(456)
-		balance1 := float64Ptr(100000.50)
-		balance2 := float64Ptr(-5000.00)
+		balance1 := Float64Ptr(100000.50)
+		balance2 := Float64Ptr(-5000.00)
 		lastAggregatedAt := "2023-01-01T00:00:00Z"
 		createdAt := "2023-01-01T00:00:00Z"
 		updatedAt := "2023-01-01T00:00:00Z"
@@ -38,17 +38,17 @@ func TestGetCorporateAccounts(t *testing.T) {
 					InstitutionEntityKey:     "test_bank_1",
 					InstitutionID:            1,
 					InstitutionAccountName:   "普通預金",
-					InstitutionAccountNumber: stringPtr("1234567"),
+					InstitutionAccountNumber: StringPtr("1234567"),
 					Nickname:                 "普通預金",
-					BranchName:               stringPtr("本店"),
-					BranchCode:               stringPtr("001"),
+					BranchName:               StringPtr("本店"),
+					BranchCode:               StringPtr("001"),
 					AggregationState:         "success",
 					AggregationStatus:        "success",
 					LastAggregatedAt:         lastAggregatedAt,
-					LastAggregatedSuccess:    stringPtr(lastAggregatedAt),
+					LastAggregatedSuccess:    StringPtr(lastAggregatedAt),
 					CurrentBalance:           balance1,
 					CurrentBalanceInBase:     balance1,
-					CurrentBalanceDataSource: stringPtr("institution"),
+					CurrentBalanceDataSource: StringPtr("institution"),
 					CreatedAt:                createdAt,
 					UpdatedAt:                updatedAt,
 				},
@@ -62,17 +62,17 @@ func TestGetCorporateAccounts(t *testing.T) {
 					InstitutionEntityKey:     "test_bank_2",
 					InstitutionID:            2,
 					InstitutionAccountName:   "クレジットカード",
-					InstitutionAccountNumber: stringPtr("****1234"),
+					InstitutionAccountNumber: StringPtr("****1234"),
 					Nickname:                 "クレジットカード",
 					BranchName:               nil,
 					BranchCode:               nil,
 					AggregationState:         "success",
 					AggregationStatus:        "success",
 					LastAggregatedAt:         lastAggregatedAt,
-					LastAggregatedSuccess:    stringPtr(lastAggregatedAt),
+					LastAggregatedSuccess:    StringPtr(lastAggregatedAt),
 					CurrentBalance:           balance2,
 					CurrentBalanceInBase:     balance2,
-					CurrentBalanceDataSource: stringPtr("institution"),
+					CurrentBalanceDataSource: StringPtr("institution"),
 					CreatedAt:                createdAt,
 					UpdatedAt:                updatedAt,
 				},
@@ -290,7 +290,7 @@ func TestGetCorporateAccounts(t *testing.T) {
 	t.Run("success case: accounts list with page parameter", func(t *testing.T) {
 		t.Parallel()
 
-		balance := float64Ptr(100000.50)
+		balance := Float64Ptr(100000.50)
 		lastAggregatedAt := "2023-01-01T00:00:00Z"
 		createdAt := "2023-01-01T00:00:00Z"
 		updatedAt := "2023-01-01T00:00:00Z"
@@ -307,17 +307,17 @@ func TestGetCorporateAccounts(t *testing.T) {
 					InstitutionEntityKey:     "test_bank_1",
 					InstitutionID:            1,
 					InstitutionAccountName:   "普通預金",
-					InstitutionAccountNumber: stringPtr("1234567"),
+					InstitutionAccountNumber: StringPtr("1234567"),
 					Nickname:                 "普通預金",
-					BranchName:               stringPtr("本店"),
-					BranchCode:               stringPtr("001"),
+					BranchName:               StringPtr("本店"),
+					BranchCode:               StringPtr("001"),
 					AggregationState:         "success",
 					AggregationStatus:        "success",
 					LastAggregatedAt:         lastAggregatedAt,
-					LastAggregatedSuccess:    stringPtr(lastAggregatedAt),
+					LastAggregatedSuccess:    StringPtr(lastAggregatedAt),
 					CurrentBalance:           balance,
 					CurrentBalanceInBase:     balance,
-					CurrentBalanceDataSource: stringPtr("institution"),
+					CurrentBalanceDataSource: StringPtr("institution"),
 					CreatedAt:                createdAt,
 					UpdatedAt:                updatedAt,
 				},
@@ -374,7 +374,7 @@ func TestGetCorporateAccounts(t *testing.T) {
 	t.Run("success case: accounts list with account_attributes", func(t *testing.T) {
 		t.Parallel()
 
-		balance := float64Ptr(100000.50)
+		balance := Float64Ptr(100000.50)
 		lastAggregatedAt := "2023-01-01T00:00:00Z"
 		createdAt := "2023-01-01T00:00:00Z"
 		updatedAt := "2023-01-01T00:00:00Z"
@@ -393,17 +393,17 @@ func TestGetCorporateAccounts(t *testing.T) {
 					InstitutionEntityKey:     "test_bank_1",
 					InstitutionID:            1,
 					InstitutionAccountName:   "普通預金",
-					InstitutionAccountNumber: stringPtr("1234567"),
+					InstitutionAccountNumber: StringPtr("1234567"),
 					Nickname:                 "普通預金",
-					BranchName:               stringPtr("本店"),
-					BranchCode:               stringPtr("001"),
+					BranchName:               StringPtr("本店"),
+					BranchCode:               StringPtr("001"),
 					AggregationState:         "success",
 					AggregationStatus:        "success",
 					LastAggregatedAt:         lastAggregatedAt,
-					LastAggregatedSuccess:    stringPtr(lastAggregatedAt),
+					LastAggregatedSuccess:    StringPtr(lastAggregatedAt),
 					CurrentBalance:           balance,
 					CurrentBalanceInBase:     balance,
-					CurrentBalanceDataSource: stringPtr("institution"),
+					CurrentBalanceDataSource: StringPtr("institution"),
 					CreatedAt:                createdAt,
 					UpdatedAt:                updatedAt,
 					AccountAttributes: &CorporateAccountAttributes{
@@ -716,6 +716,87 @@ func TestGetCorporateAccountBalances(t *testing.T) {
 		}
 	})
 
+	t.Run("success case: balances list with start_date and end_date parameters", func(t *testing.T) {
+		t.Parallel()
+
+		accountID := "account_key_123"
+		startDate := "2023-01-01"
+		endDate := "2023-01-31"
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if actual := r.URL.Query().Get("start_date"); actual != startDate {
+				t.Errorf("expected start_date parameter %s, got %s", startDate, actual)
+			}
+			if actual := r.URL.Query().Get("end_date"); actual != endDate {
+				t.Errorf("expected end_date parameter %s, got %s", endDate, actual)
+			}
+			if r.URL.Query().Has("until") {
+				t.Errorf("expected no until parameter, got %s", r.URL.RawQuery)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			if err := json.NewEncoder(w).Encode(CorporateAccountBalances{}); err != nil {
+				t.Errorf("failed to encode response: %v", err)
+			}
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		setTestToken(client, "test-access-token")
+		_, err = client.GetCorporateAccountBalances(context.Background(), accountID,
+			WithStartDateForCorporateBalances(startDate),
+			WithEndDateForCorporateBalances(endDate),
+		)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+	})
+
+	t.Run("error case: returns error when the date window is invalid", func(t *testing.T) {
+		t.Parallel()
+
+		baseURL, err := url.Parse("https://test.getmoneytree.com/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+		setTestToken(client, "test-token")
+
+		tests := []struct {
+			name    string
+			opts    []GetCorporateAccountBalancesOption
+			wantErr string
+		}{
+			{name: "start_date without end_date", opts: []GetCorporateAccountBalancesOption{WithStartDateForCorporateBalances("2023-01-01")}, wantErr: "end_date is required"},
+			{name: "end_date without start_date", opts: []GetCorporateAccountBalancesOption{WithEndDateForCorporateBalances("2023-01-31")}, wantErr: "start_date is required"},
+			{name: "invalid format", opts: []GetCorporateAccountBalancesOption{WithStartDateForCorporateBalances("2023-01-01"), WithEndDateForCorporateBalances("2023/01/31")}, wantErr: "date must be in format YYYY-MM-DD"},
+			{name: "start_date after end_date", opts: []GetCorporateAccountBalancesOption{WithStartDateForCorporateBalances("2023-02-01"), WithEndDateForCorporateBalances("2023-01-31")}, wantErr: "start_date must be on or before end_date"},
+		}
+		for _, tt := range tests {
+			_, err := client.GetCorporateAccountBalances(context.Background(), "account_key_123", tt.opts...)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("%s: expected error containing %q, got %v", tt.name, tt.wantErr, err)
+			}
+		}
+	})
+
 	t.Run("success case: balances list with page and per_page parameters", func(t *testing.T) {
 		t.Parallel()
 
@@ -1708,7 +1789,7 @@ func TestUpdateCorporateAccountTransaction(t *testing.T) {
 
 		request := &UpdateCorporateAccountTransactionRequest{
 			DescriptionGuest: &descriptionGuest,
-			CategoryID:       int64Ptr(123),
+			CategoryID:       Int64Ptr(123),
 		}
 
 		setTestToken(client, "test-access-token")
@@ -1851,7 +1932,7 @@ func TestUpdateCorporateAccountTransaction(t *testing.T) {
 		}
 
 		request := &UpdateCorporateAccountTransactionRequest{
-			CategoryID: int64Ptr(789),
+			CategoryID: Int64Ptr(789),
 		}
 
 		setTestToken(client, "test-access-token")
@@ -1944,7 +2025,7 @@ func TestUpdateCorporateAccountTransaction(t *testing.T) {
 		}
 
 		request := &UpdateCorporateAccountTransactionRequest{
-			DescriptionGuest: stringPtr("test"),
+			DescriptionGuest: StringPtr("test"),
 		}
 
 		// Token is not set, so refreshToken should fail
@@ -1969,7 +2050,7 @@ func TestUpdateCorporateAccountTransaction(t *testing.T) {
 		}
 
 		request := &UpdateCorporateAccountTransactionRequest{
-			DescriptionGuest: stringPtr("test"),
+			DescriptionGuest: StringPtr("test"),
 		}
 
 		setTestToken(client, "test-token")
@@ -2049,7 +2130,7 @@ func TestUpdateCorporateAccountTransaction(t *testing.T) {
 		}
 
 		request := &UpdateCorporateAccountTransactionRequest{
-			CategoryID: int64Ptr(99999),
+			CategoryID: Int64Ptr(99999),
 		}
 
 		setTestToken(client, "test-token")
@@ -2083,7 +2164,7 @@ func TestUpdateCorporateAccountTransaction(t *testing.T) {
 		}
 
 		request := &UpdateCorporateAccountTransactionRequest{
-			DescriptionGuest: stringPtr("test"),
+			DescriptionGuest: StringPtr("test"),
 		}
 
 		setTestToken(client, "test-token")
@@ -0,0 +1,116 @@
+package moneytree
+
+import (
+	"fmt"
+	"time"
+)
+
+// layoutsOr returns layouts, the layouts given to a ParsedX helper, or documented, the layouts
+// the API documents for the field, if none are given.
+func layoutsOr(layouts []string, documented ...string) []string {
+	if len(layouts) > 0 {
+		return layouts
+	}
+	return documented
+}
+
+// parseTime parses value, the field of a response named name, with the first of layouts that matches it.
+func parseTime(name, value string, layouts []string) (time.Time, error) {
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("failed to parse %s %q: expected one of the layouts %q", name, value, layouts)
+}
+
+// parseOptionalTime parses value like parseTime. The returned bool reports whether value is present;
+// it is false with a nil error when value is nil.
+func parseOptionalTime(name string, value *string, layouts []string) (time.Time, bool, error) {
+	if value == nil {
+		return time.Time{}, false, nil
+	}
+	t, err := parseTime(name, *value, layouts)
+	return t, true, err
+}
+
+// ParsedDate parses Date as a "2006-01-02" date in UTC, or with the first of layouts that matches it
+// if any are given, e.g. the Config.DateLayouts returned by Client.DateLayouts.
+func (b PersonalAccountBalance) ParsedDate(layouts ...string) (time.Time, error) {
+	return parseTime("date", b.Date, layoutsOr(layouts, time.DateOnly))
+}
+
+// ParsedDate parses Date as a "2006-01-02" date in UTC, or with the first of layouts that matches it
+// if any are given, e.g. the Config.DateLayouts returned by Client.DateLayouts.
+func (b CorporateAccountBalance) ParsedDate(layouts ...string) (time.Time, error) {
+	return parseTime("date", b.Date, layoutsOr(layouts, time.DateOnly))
+}
+
+// ParsedDate parses Date as a "2006-01-02" date in UTC, or with the first of layouts that matches it
+// if any are given, e.g. the Config.DateLayouts returned by Client.DateLayouts.
+func (d AccountBalanceDetail) ParsedDate(layouts ...string) (time.Time, error) {
+	return parseTime("date", d.Date, layoutsOr(layouts, time.DateOnly))
+}
+
+// ParsedDate parses Date as a "2006-01-02" date in UTC, or with the first of layouts that matches it
+// if any are given, e.g. the Config.DateLayouts returned by Client.DateLayouts.
+func (d AccountDueBalance) ParsedDate(layouts ...string) (time.Time, error) {
+	return parseTime("date", d.Date, layoutsOr(layouts, time.DateOnly))
+}
+
+// ParsedDueDate parses DueDate as a "2006-01-02" date in UTC, or with the first of layouts that matches it
+// if any are given, e.g. the Config.DateLayouts returned by Client.DateLayouts.
+func (d AccountDueBalance) ParsedDueDate(layouts ...string) (time.Time, error) {
+	return parseTime("due date", d.DueDate, layoutsOr(layouts, time.DateOnly))
+}
+
+// ParsedDate parses Date as a "2006-01-02" date in UTC, or with the first of layouts that matches it
+// if any are given, e.g. the Config.DateLayouts returned by Client.DateLayouts.
+func (t TermDeposit) ParsedDate(layouts ...string) (time.Time, error) {
+	return parseTime("date", t.Date, layoutsOr(layouts, time.DateOnly))
+}
+
+// ParsedPurchaseDate parses PurchaseDate as a "2006-01-02" date in UTC, or with the first of layouts that matches it
+// if any are given, e.g. the Config.DateLayouts returned by Client.DateLayouts.
+// The returned bool reports whether PurchaseDate is present; it is false with a nil error when PurchaseDate is nil.
+func (t TermDeposit) ParsedPurchaseDate(layouts ...string) (time.Time, bool, error) {
+	return parseOptionalTime("purchase date", t.PurchaseDate, layoutsOr(layouts, time.DateOnly))
+}
+
+// ParsedDate parses Date as a "2006-01-02" date in UTC, or with the first of layouts that matches it
+// if any are given, e.g. the Config.DateLayouts returned by Client.DateLayouts.
+func (p InvestmentPosition) ParsedDate(layouts ...string) (time.Time, error) {
+	return parseTime("date", p.Date, layoutsOr(layouts, time.DateOnly))
+}
+
+// ParsedDate parses Date as an RFC 3339 date-time, keeping its offset, or with the first of layouts that matches it
+// if any are given, e.g. the Config.DateLayouts returned by Client.DateLayouts.
+// It also applies to InvestmentAccountTransaction and PointAccountTransaction, which are aliases of this type.
+func (t PersonalAccountTransaction) ParsedDate(layouts ...string) (time.Time, error) {
+	return parseTime("date", t.Date, layoutsOr(layouts, time.RFC3339))
+}
+
+// ParsedUpdatedAt parses UpdatedAt as an RFC 3339 date-time, keeping its offset, or with the first of layouts that
+// matches it if any are given, e.g. the Config.DateLayouts returned by Client.DateLayouts.
+// It also applies to InvestmentAccountTransaction and PointAccountTransaction, which are aliases of this type.
+func (t PersonalAccountTransaction) ParsedUpdatedAt(layouts ...string) (time.Time, error) {
+	return parseTime("updated at", t.UpdatedAt, layoutsOr(layouts, time.RFC3339))
+}
+
+// ParsedDate parses Date as an RFC 3339 date-time, keeping its offset, or with the first of layouts that matches it
+// if any are given, e.g. the Config.DateLayouts returned by Client.DateLayouts.
+func (t CorporateAccountTransaction) ParsedDate(layouts ...string) (time.Time, error) {
+	return parseTime("date", t.Date, layoutsOr(layouts, time.RFC3339))
+}
+
+// ParsedExpirationDate parses ExpirationDate as a "2006-01-02" date in UTC, or with the first of layouts that matches it
+// if any are given, e.g. the Config.DateLayouts returned by Client.DateLayouts.
+func (e PointExpiration) ParsedExpirationDate(layouts ...string) (time.Time, error) {
+	return parseTime("expiration date", e.ExpirationDate, layoutsOr(layouts, time.DateOnly))
+}
+
+// ParsedDate parses Date as an RFC 3339 date-time, keeping its offset, or with the first of layouts that matches it
+// if any are given, e.g. the Config.DateLayouts returned by Client.DateLayouts.
+func (e PointExpiration) ParsedDate(layouts ...string) (time.Time, error) {
+	return parseTime("date", e.Date, layoutsOr(layouts, time.RFC3339))
+}
@@ -0,0 +1,63 @@
+package moneytree
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParsedDate(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2023, time.December, 1, 0, 0, 0, 0, time.UTC)
+	dateTime := time.Date(2023, time.December, 1, 10, 0, 0, 0, time.FixedZone("", 9*60*60))
+	tests := []struct {
+		name     string
+		parse    func(layouts ...string) (time.Time, error)
+		expected time.Time
+	}{
+		{name: "PersonalAccountBalance.Date", parse: PersonalAccountBalance{Date: "2023-12-01"}.ParsedDate, expected: date},
+		{name: "CorporateAccountBalance.Date", parse: CorporateAccountBalance{Date: "2023-12-01"}.ParsedDate, expected: date},
+		{name: "AccountBalanceDetail.Date", parse: AccountBalanceDetail{Date: "2023-12-01"}.ParsedDate, expected: date},
+		{name: "AccountDueBalance.DueDate", parse: AccountDueBalance{DueDate: "2023-12-01"}.ParsedDueDate, expected: date},
+		{name: "TermDeposit.Date", parse: TermDeposit{Date: "2023-12-01"}.ParsedDate, expected: date},
+		{name: "InvestmentPosition.Date", parse: InvestmentPosition{Date: "2023-12-01"}.ParsedDate, expected: date},
+		{name: "PointExpiration.ExpirationDate", parse: PointExpiration{ExpirationDate: "2023-12-01"}.ParsedExpirationDate, expected: date},
+		{name: "PersonalAccountTransaction.Date", parse: PersonalAccountTransaction{Date: "2023-12-01T10:00:00+09:00"}.ParsedDate, expected: dateTime},
+		{name: "CorporateAccountTransaction.Date", parse: CorporateAccountTransaction{Date: "2023-12-01T10:00:00+09:00"}.ParsedDate, expected: dateTime},
+		{name: "PersonalAccountTransaction.UpdatedAt", parse: PersonalAccountTransaction{UpdatedAt: "2023-12-01T10:00:00+09:00"}.ParsedUpdatedAt, expected: dateTime},
+		{name: "PointExpiration.Date", parse: PointExpiration{Date: "2023-12-01T10:00:00+09:00"}.ParsedDate, expected: dateTime},
+	}
+	for _, tt := range tests {
+		t.Run("success case: "+tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.parse()
+			if err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+			if !got.Equal(tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+
+	t.Run("error case: a value in the layout of another field returns error", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := (PersonalAccountBalance{Date: "2023-12-01T10:00:00Z"}).ParsedDate(); err == nil {
+			t.Error("expected error for a date-time balance date, got nil")
+		}
+		if _, err := (PersonalAccountTransaction{Date: "2023-12-01"}).ParsedDate(); err == nil {
+			t.Error("expected error for a date-only transaction date, got nil")
+		}
+	})
+
+	t.Run("success case: nil purchase date is reported as absent", func(t *testing.T) {
+		t.Parallel()
+
+		purchaseDate, ok, err := TermDeposit{}.ParsedPurchaseDate()
+		if err != nil || ok || !purchaseDate.IsZero() {
+			t.Errorf("expected (zero, false, nil), got (%v, %v, %v)", purchaseDate, ok, err)
+		}
+	})
+}
@@ -0,0 +1,321 @@
+package moneytree
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// redactedAuthorization replaces the Authorization header value in debug dumps.
+const redactedAuthorization = "[REDACTED]"
+
+// defaultMaxBodyLogBytes is the number of body bytes dumped per request or response by default.
+const defaultMaxBodyLogBytes = 4096
+
+// truncatedBodyMarker follows a body in debug dumps when the rest of the body was cut off.
+const truncatedBodyMarker = "[truncated]"
+
+// WithDebug dumps every request sent and response received by the Client to w,
+// including headers and bodies, as they appear on the wire. If w is nil, os.Stderr is used.
+// Credentials are never written: the Authorization header is redacted, and so are the secret
+// members of JSON bodies and the secret fields of form bodies, in requests and responses alike
+// (client_secret, access_token, refresh_token, token, code and code_verifier), e.g. for the
+// OAuth token endpoints. Bodies may still contain personal data; use WithLogRedactor to mask
+// other fields. Long bodies are truncated; see WithMaxBodyLogBytes.
+//
+// This option is meant for interactive debugging only; do not enable it in production.
+//
+// Example:
+//
+//	client, err := moneytree.NewClient("jp-api-staging",
+//		moneytree.WithDebug(os.Stderr),
+//	)
+func WithDebug(w io.Writer) NewClientOption {
+	return func(c *Client) {
+		if w == nil {
+			w = os.Stderr
+		}
+		c.debugWriter = w
+	}
+}
+
+// WithMaxBodyLogBytes limits the number of bytes of each request and response body written by WithDebug.
+// A body longer than n bytes is cut off and followed by a "[truncated]" marker; the request sent
+// and the response decoded are not affected. If n is zero or negative, bodies are dumped in full.
+// The default is 4096 bytes, so that large transaction pages do not flood the debug output.
+//
+// Example:
+//
+//	client, err := moneytree.NewClient("jp-api-staging",
+//		moneytree.WithDebug(os.Stderr),
+//		moneytree.WithMaxBodyLogBytes(1024),
+//	)
+func WithMaxBodyLogBytes(n int) NewClientOption {
+	return func(c *Client) {
+		if n <= 0 {
+			// The zero value of the field means the default, so no limit is stored as -1.
+			n = -1
+		}
+		c.maxBodyLogBytes = n
+	}
+}
+
+// bodyLogLimit returns the maximum number of body bytes to dump, or a negative number for no limit.
+func (c *Client) bodyLogLimit() int {
+	if c.maxBodyLogBytes == 0 {
+		return defaultMaxBodyLogBytes
+	}
+	return c.maxBodyLogBytes
+}
+
+// truncateBody returns body cut off at limit bytes, followed by truncatedBodyMarker if anything was cut off.
+// A negative limit returns body as is.
+func truncateBody(body []byte, limit int) []byte {
+	if limit < 0 || len(body) <= limit {
+		return body
+	}
+	truncated := make([]byte, 0, limit+len(truncatedBodyMarker)+1)
+	truncated = append(truncated, body[:limit]...)
+	truncated = append(truncated, '\n')
+	return append(truncated, truncatedBodyMarker...)
+}
+
+// WithPrettyPrintRequestBody indents the JSON bodies of outgoing requests in the dumps written by WithDebug,
+// so that the exact document sent by calls such as UpdatePersonalAccountTransaction is easy to read
+// when the API rejects it. Members are listed in alphabetical order. Secret members are redacted
+// whether or not this option is set (see WithDebug). The body sent to the API is not affected,
+// and bodies that are not JSON are dumped as is.
+//
+// Example:
+//
+//	client, err := moneytree.NewClient("jp-api-staging",
+//		moneytree.WithDebug(os.Stderr),
+//		moneytree.WithPrettyPrintRequestBody(),
+//	)
+func WithPrettyPrintRequestBody() NewClientOption {
+	return func(c *Client) {
+		c.prettyPrintRequestBody = true
+	}
+}
+
+// isSecretMember reports whether a JSON member or form field with name holds a secret that must not be dumped.
+func isSecretMember(name string) bool {
+	switch name {
+	case "client_secret", "access_token", "refresh_token", "token", "code", "code_verifier":
+		return true
+	default:
+		return false
+	}
+}
+
+// redactSecretMember is the redactor of redactMembers that replaces the values of the secret members.
+func redactSecretMember(name, value string) string {
+	if isSecretMember(name) {
+		return redactedAuthorization
+	}
+	return value
+}
+
+// redactSecretForm returns body, a form body, with the values of its secret fields replaced with
+// "[REDACTED]", or body as is if it holds no secret.
+func redactSecretForm(body []byte) []byte {
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		// The body cannot be parsed, so no field can be told apart from its secret.
+		return []byte(redactedAuthorization)
+	}
+	changed := false
+	for name, values := range form {
+		if isSecretMember(name) {
+			for i := range values {
+				values[i] = redactedAuthorization
+			}
+			changed = true
+		}
+	}
+	if !changed {
+		return body
+	}
+	return []byte(form.Encode())
+}
+
+// dumpBody returns body as it is dumped: the values of its secret JSON members or form fields are
+// replaced with "[REDACTED]", the log redactor is applied to its JSON members, and a JSON body is
+// indented if indent is true. contentType is the Content-Type header of the body: form bodies are
+// only recognized by it, as any text parses as a query string. Body is returned as is if it is
+// neither, or if nothing was changed; otherwise, the body is re-encoded, so its layout may differ
+// from the original.
+func (c *Client) dumpBody(body []byte, contentType string, indent bool) []byte {
+	if len(body) == 0 {
+		return body
+	}
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		return redactSecretForm(body)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	// Numbers are kept as written, so that large IDs and amounts are not rounded in the dump.
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return body
+	}
+	changed := redactMembers(value, redactSecretMember)
+	if redactor := c.logRedactor(); redactor != nil {
+		changed = redactMembers(value, redactor) || changed
+	}
+	if !changed && !indent {
+		return body
+	}
+
+	var encoded []byte
+	var err error
+	if indent {
+		encoded, err = json.MarshalIndent(value, "", "  ")
+	} else {
+		encoded, err = json.Marshal(value)
+	}
+	if err != nil {
+		if changed {
+			// The redacted value cannot be encoded, and the original body may hold a secret.
+			return []byte(redactedAuthorization)
+		}
+		return body
+	}
+	return encoded
+}
+
+// dumpRequest writes req to the debug writer, if any. bodyBytes is the request body,
+// which is passed separately because req.Body can only be read once.
+func (c *Client) dumpRequest(req *http.Request, bodyBytes []byte) {
+	if c.debugWriter == nil {
+		return
+	}
+
+	dumpReq := req.Clone(req.Context())
+	if dumpReq.Header.Get("Authorization") != "" {
+		dumpReq.Header.Set("Authorization", redactedAuthorization)
+	}
+	c.redactHeader(dumpReq.Header)
+	dumpReq.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+	dump, err := httputil.DumpRequestOut(dumpReq, false)
+	if err != nil {
+		_, _ = fmt.Fprintf(c.debugWriter, "moneytree: failed to dump request: %v\n", err)
+		return
+	}
+	bodyBytes = c.dumpBody(bodyBytes, req.Header.Get("Content-Type"), c.prettyPrintRequestBody)
+	c.writeDump("request", append(dump, truncateBody(bodyBytes, c.bodyLogLimit())...))
+}
+
+// dumpResponse writes resp to the debug writer, if any.
+// The response body is buffered and restored so that it can still be decoded.
+func (c *Client) dumpResponse(resp *http.Response) {
+	if c.debugWriter == nil {
+		return
+	}
+
+	dumpResp := *resp
+	dumpResp.Header = resp.Header.Clone()
+	c.redactHeader(dumpResp.Header)
+	dump, err := httputil.DumpResponse(&dumpResp, false)
+	if err != nil {
+		_, _ = fmt.Fprintf(c.debugWriter, "moneytree: failed to dump response: %v\n", err)
+		return
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		// The read error is replayed after the bytes read so far, so that the caller still
+		// detects the truncated body (see ErrTruncatedResponse).
+		resp.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), errReader{err: err}))
+		_, _ = fmt.Fprintf(c.debugWriter, "moneytree: failed to read response body: %v\n", err)
+	}
+	redacted := c.dumpBody(body, resp.Header.Get("Content-Type"), false)
+	c.writeDump("response", append(dump, truncateBody(redacted, c.bodyLogLimit())...))
+}
+
+// logRedactor returns Config.LogRedactor, or nil if it is not set.
+func (c *Client) logRedactor() func(key, value string) string {
+	if c.config == nil {
+		return nil
+	}
+	return c.config.LogRedactor
+}
+
+// redactHeader applies the log redactor to every value of header, which must be a copy
+// of the header sent or received.
+func (c *Client) redactHeader(header http.Header) {
+	redactor := c.logRedactor()
+	if redactor == nil {
+		return
+	}
+	for key, values := range header {
+		for i, value := range values {
+			values[i] = redactor(key, value)
+		}
+	}
+}
+
+// redactMembers applies redactor to the scalar members of a decoded JSON value, recursively,
+// and reports whether any member was changed. A changed member is replaced with a string.
+func redactMembers(value any, redactor func(key, value string) string) bool {
+	changed := false
+	switch v := value.(type) {
+	case map[string]any:
+		for name, member := range v {
+			var str string
+			switch m := member.(type) {
+			case string:
+				str = m
+			case json.Number:
+				str = m.String()
+			case bool:
+				str = strconv.FormatBool(m)
+			default:
+				if redactMembers(member, redactor) {
+					changed = true
+				}
+				continue
+			}
+			if redacted := redactor(name, str); redacted != str {
+				v[name] = redacted
+				changed = true
+			}
+		}
+	case []any:
+		for _, element := range v {
+			if redactMembers(element, redactor) {
+				changed = true
+			}
+		}
+	}
+	return changed
+}
+
+// writeDump writes a dump with a header line in a single Write call, so that the dumps
+// of concurrent requests are not interleaved.
+func (c *Client) writeDump(kind string, dump []byte) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "---- moneytree %s ----\n", kind)
+	buf.Write(dump)
+	buf.WriteString("\n")
+	_, _ = c.debugWriter.Write(buf.Bytes())
+}
+
+// errReader is an io.Reader that always fails with err.
+type errReader struct {
+	err error
+}
+
+// Read implements io.Reader.
+func (r errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
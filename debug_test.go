@@ -0,0 +1,447 @@
+package moneytree
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestWithDebug(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: request and response are dumped with the token masked", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"locale_identifier": "ja_JP", "email": "user@example.com"}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		var buf bytes.Buffer
+		client, err := NewClient("jp-api-staging", WithDebug(&buf))
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		client.config.BaseURL = baseURL
+		setTestToken(client, "secret-access-token")
+
+		profile, err := client.GetProfile(context.Background())
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		// The response body must still be decodable after being dumped.
+		if profile.LocaleIdentifier != "ja_JP" {
+			t.Errorf("expected LocaleIdentifier ja_JP, got %s", profile.LocaleIdentifier)
+		}
+
+		dump := buf.String()
+		if strings.Contains(dump, "secret-access-token") {
+			t.Errorf("expected the access token to be masked, got %s", dump)
+		}
+		for _, expected := range []string{
+			"GET /link/profile.json HTTP/1.1",
+			"Authorization: " + redactedAuthorization,
+			"HTTP/1.1 200 OK",
+			`"email": "user@example.com"`,
+		} {
+			if !strings.Contains(dump, expected) {
+				t.Errorf("expected dump to contain %q, got %s", expected, dump)
+			}
+		}
+	})
+
+	t.Run("success case: request body is dumped and still sent", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body bytes.Buffer
+			_, _ = body.ReadFrom(r.Body)
+			if !strings.Contains(body.String(), `"name":"Groceries"`) {
+				t.Errorf("expected request body to contain the category name, got %s", body.String())
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": 1, "name": "Groceries"}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		var buf bytes.Buffer
+		client, err := NewClient("jp-api-staging", WithDebug(&buf))
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		client.config.BaseURL = baseURL
+		setTestToken(client, "secret-access-token")
+
+		if _, err := client.CreateCategory(context.Background(), &CreateCategoryRequest{Name: "Groceries"}); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if !strings.Contains(buf.String(), `"name":"Groceries"`) {
+			t.Errorf("expected dump to contain the request body, got %s", buf.String())
+		}
+	})
+
+	t.Run("success case: the token request and response are dumped without secrets", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Path == "/oauth/revoke" {
+				_, _ = w.Write([]byte(`{}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"access_token": "secret-access-token", "refresh_token": "secret-refresh-token", "token_type": "bearer", "expires_in": 3600}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		var buf bytes.Buffer
+		client, err := NewClient("jp-api-staging", WithDebug(&buf), WithMaxBodyLogBytes(-1))
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		client.config.BaseURL = baseURL
+		client.config.ClientID = "client-id"
+		client.config.ClientSecret = "secret-client-secret"
+
+		token, err := client.RetrieveToken(context.Background(), &RetrieveTokenRequest{
+			GrantType:    StringPtr("authorization_code"),
+			Code:         StringPtr("secret-code"),
+			CodeVerifier: StringPtr("secret-code-verifier"),
+		})
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		// The response decoded by the caller is not redacted.
+		if *token.AccessToken != "secret-access-token" {
+			t.Errorf("expected the access token to be decoded, got %s", *token.AccessToken)
+		}
+		if err := client.RevokeToken(context.Background(), &RevokeTokenRequest{Token: "secret-revoked-token"}); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+
+		dump := buf.String()
+		for _, secret := range []string{
+			"secret-client-secret", "secret-code", "secret-code-verifier",
+			"secret-access-token", "secret-refresh-token", "secret-revoked-token",
+		} {
+			if strings.Contains(dump, secret) {
+				t.Errorf("expected %s to be redacted, got %s", secret, dump)
+			}
+		}
+		for _, expected := range []string{
+			`"client_id":"client-id"`,
+			`"client_secret":"` + redactedAuthorization + `"`,
+			`"access_token":"` + redactedAuthorization + `"`,
+			`"token_type":"bearer"`,
+			"client_secret=%5BREDACTED%5D",
+			"token=%5BREDACTED%5D",
+		} {
+			if !strings.Contains(dump, expected) {
+				t.Errorf("expected dump to contain %q, got %s", expected, dump)
+			}
+		}
+	})
+
+	t.Run("success case: nil writer defaults to stderr", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewClient("jp-api-staging", WithDebug(nil))
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if client.debugWriter != os.Stderr {
+			t.Errorf("expected debug writer to be os.Stderr, got %v", client.debugWriter)
+		}
+	})
+}
+
+func TestWithMaxBodyLogBytes(t *testing.T) {
+	t.Parallel()
+
+	largeEmail := strings.Repeat("a", 10000) + "@example.com"
+	body := `{"email": "` + largeEmail + `"}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	baseURL, err := url.Parse(server.URL + "/")
+	if err != nil {
+		t.Fatalf("failed to parse base URL: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		opts         []NewClientOption
+		expectedBody string
+	}{
+		{name: "success case: body is truncated at the default limit", opts: nil, expectedBody: body[:defaultMaxBodyLogBytes] + "\n" + truncatedBodyMarker},
+		{name: "success case: body is truncated at the given limit", opts: []NewClientOption{WithMaxBodyLogBytes(100)}, expectedBody: body[:100] + "\n" + truncatedBodyMarker},
+		{name: "success case: body is dumped in full without a limit", opts: []NewClientOption{WithMaxBodyLogBytes(0)}, expectedBody: body},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			client, err := NewClient("jp-api-staging", append([]NewClientOption{WithDebug(&buf)}, tt.opts...)...)
+			if err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+			client.config.BaseURL = baseURL
+			setTestToken(client, "test-access-token")
+
+			profile, err := client.GetProfile(context.Background())
+			if err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+			// The decoded response is never truncated.
+			if profile.Email != largeEmail {
+				t.Errorf("expected the full email to be decoded, got %d bytes", len(profile.Email))
+			}
+
+			dump := buf.String()
+			if !strings.Contains(dump, tt.expectedBody) {
+				t.Errorf("expected dump to contain %d body bytes, got %d bytes of dump", len(tt.expectedBody), len(dump))
+			}
+			if tt.expectedBody == body && strings.Contains(dump, truncatedBodyMarker) {
+				t.Error("expected no truncation marker")
+			}
+		})
+	}
+}
+
+func TestWithPrettyPrintRequestBody(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: the JSON body of an update is dumped indented", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body bytes.Buffer
+			_, _ = body.ReadFrom(r.Body)
+			// The body sent to the API stays compact.
+			if strings.Contains(body.String(), "\n  ") {
+				t.Errorf("expected a compact request body, got %s", body.String())
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id": 1}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		var buf bytes.Buffer
+		client, err := NewClient("jp-api-staging", WithDebug(&buf), WithPrettyPrintRequestBody())
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		client.config.BaseURL = baseURL
+		setTestToken(client, "test-access-token")
+
+		_, err = client.UpdatePersonalAccountTransaction(context.Background(), "account_key_123", 1, &UpdatePersonalAccountTransactionRequest{
+			DescriptionGuest: StringPtr("Lunch"),
+			CategoryID:       Int64Ptr(12345678901234),
+		})
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+
+		expected := "{\n  \"category_id\": 12345678901234,\n  \"description_guest\": \"Lunch\"\n}"
+		if !strings.Contains(buf.String(), expected) {
+			t.Errorf("expected dump to contain %q, got %s", expected, buf.String())
+		}
+	})
+
+	t.Run("success case: secrets are redacted whether or not the body is pretty-printed", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"access_token": "secret-access", "refresh_token": "secret-refresh", "nested": [{"code": "secret-nested"}]}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		for _, pretty := range []bool{false, true} {
+			var buf bytes.Buffer
+			opts := []NewClientOption{WithDebug(&buf), WithMaxBodyLogBytes(-1)}
+			if pretty {
+				opts = append(opts, WithPrettyPrintRequestBody())
+			}
+			client, err := NewClient("jp-api-staging", opts...)
+			if err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+			client.config.BaseURL = baseURL
+			client.config.ClientSecret = "secret-client"
+
+			// A JSON request body and a JSON response.
+			if _, err := client.RetrieveToken(context.Background(), &RetrieveTokenRequest{
+				GrantType:    StringPtr("refresh_token"),
+				RefreshToken: StringPtr("secret-refresh-request"),
+			}); err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+			// A form request body.
+			if err := client.RevokeToken(context.Background(), &RevokeTokenRequest{Token: "secret-revoked"}); err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+
+			dump := buf.String()
+			for _, secret := range []string{"secret-client", "secret-refresh-request", "secret-revoked", "secret-access", "secret-refresh", "secret-nested"} {
+				if strings.Contains(dump, secret) {
+					t.Errorf("pretty=%v: expected %s to be redacted, got %s", pretty, secret, dump)
+				}
+			}
+			if !strings.Contains(dump, "grant_type") {
+				t.Errorf("pretty=%v: expected the other members to be dumped, got %s", pretty, dump)
+			}
+		}
+	})
+
+	t.Run("success case: a form body without secrets is kept as is", func(t *testing.T) {
+		t.Parallel()
+
+		body := []byte("grant_type=refresh_token&scope=accounts_read")
+		if got := (&Client{}).dumpBody(body, "application/x-www-form-urlencoded", true); !bytes.Equal(got, body) {
+			t.Errorf("expected %s, got %s", body, got)
+		}
+	})
+
+	t.Run("success case: a body that is not JSON is kept as is", func(t *testing.T) {
+		t.Parallel()
+
+		body := []byte("grant_type=refresh_token&token=abc")
+		if got := (&Client{}).dumpBody(body, "text/plain", true); !bytes.Equal(got, body) {
+			t.Errorf("expected %s, got %s", body, got)
+		}
+	})
+}
+
+func TestWithLogRedactor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: a custom redactor masks a member of the response body", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("X-Guest-Name", "Taro Yamada")
+			_, _ = w.Write([]byte(`{"accounts": [{"id": 1, "institution_account_number": "1234567", "nickname": "Savings"}]}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		var buf bytes.Buffer
+		client, err := NewClient("jp-api-staging",
+			WithDebug(&buf),
+			WithLogRedactor(func(key, value string) string {
+				if key == "institution_account_number" || key == "X-Guest-Name" {
+					return "***"
+				}
+				return value
+			}),
+		)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		client.config.BaseURL = baseURL
+		setTestToken(client, "secret-access-token")
+
+		accounts, err := client.GetCorporateAccounts(context.Background())
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		// The decoded response must not be redacted.
+		if got := accounts.Accounts[0].InstitutionAccountNumber; got == nil || *got != "1234567" {
+			t.Errorf("expected InstitutionAccountNumber 1234567, got %v", got)
+		}
+
+		dump := buf.String()
+		for _, unexpected := range []string{"1234567", "Taro Yamada", "secret-access-token"} {
+			if strings.Contains(dump, unexpected) {
+				t.Errorf("expected %q to be masked, got %s", unexpected, dump)
+			}
+		}
+		for _, expected := range []string{
+			`"institution_account_number":"***"`,
+			`"nickname":"Savings"`,
+			"X-Guest-Name: ***",
+			"Authorization: " + redactedAuthorization,
+		} {
+			if !strings.Contains(dump, expected) {
+				t.Errorf("expected dump to contain %q, got %s", expected, dump)
+			}
+		}
+	})
+
+	t.Run("success case: a body the redactor does not change is dumped as is", func(t *testing.T) {
+		t.Parallel()
+
+		client := &Client{
+			config: &Config{
+				LogRedactor: func(key, value string) string { return value },
+			},
+		}
+		body := []byte(`{"id": 1,  "name": "Groceries"}`)
+		if got := client.dumpBody(body, "application/json", false); !bytes.Equal(got, body) {
+			t.Errorf("expected %s, got %s", body, got)
+		}
+	})
+
+	t.Run("success case: an indented body stays indented after the secrets and the redactor are applied", func(t *testing.T) {
+		t.Parallel()
+
+		client := &Client{
+			config: &Config{
+				LogRedactor: func(key, value string) string {
+					if key == "name" {
+						return "***"
+					}
+					return value
+				},
+			},
+		}
+		body := []byte(`{"name": "Groceries", "token": "secret"}`)
+		expected := "{\n  \"name\": \"***\",\n  \"token\": \"" + redactedAuthorization + "\"\n}"
+		if got := client.dumpBody(body, "application/json", true); string(got) != expected {
+			t.Errorf("expected %s, got %s", expected, got)
+		}
+	})
+}
@@ -0,0 +1,166 @@
+package moneytree
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// decodeTargets lists the response types whose nullable fields are checked by the fuzz tests.
+func decodeTargets() []any {
+	return []any{
+		PersonalAccount{},
+		PersonalAccountBalance{},
+		PersonalAccountTransaction{},
+		TermDeposit{},
+		CorporateAccount{},
+		CorporateAccountTransaction{},
+		InvestmentAccount{},
+		InvestmentPosition{},
+		PointAccount{},
+	}
+}
+
+// jsonFieldName returns the JSON member name of a struct field, or "" if the field is not encoded.
+func jsonFieldName(field reflect.StructField) string {
+	tag := field.Tag.Get("json")
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "-" || !field.IsExported() {
+		return ""
+	}
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+// sampleJSONValue returns a non-null JSON value that decodes into a value of type typ.
+func sampleJSONValue(typ reflect.Type) any {
+	switch typ.Kind() {
+	case reflect.Pointer:
+		return sampleJSONValue(typ.Elem())
+	case reflect.String:
+		return "value"
+	case reflect.Bool:
+		return true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return 1
+	case reflect.Float32, reflect.Float64:
+		return 1.5
+	case reflect.Slice:
+		return []any{sampleJSONValue(typ.Elem())}
+	case reflect.Map:
+		return map[string]any{}
+	default:
+		return map[string]any{}
+	}
+}
+
+// checkNullableFields builds a JSON object for the type of target in which the i-th nullable
+// (pointer or slice) field is null when bit i of mask is set, decodes it, and reports
+// whether each nullable field is nil exactly when its member was null.
+func checkNullableFields(t *testing.T, target any, mask uint64) {
+	t.Helper()
+
+	typ := reflect.TypeOf(target)
+	payload := make(map[string]any)
+	nullable := make(map[string]bool)
+	bit := 0
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		name := jsonFieldName(field)
+		if name == "" {
+			continue
+		}
+		kind := field.Type.Kind()
+		if kind != reflect.Pointer && kind != reflect.Slice {
+			payload[name] = sampleJSONValue(field.Type)
+			continue
+		}
+		isNull := mask&(1<<(bit%64)) != 0
+		bit++
+		nullable[field.Name] = isNull
+		if isNull {
+			payload[name] = nil
+		} else {
+			payload[name] = sampleJSONValue(field.Type)
+		}
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("failed to marshal payload: %v", err)
+	}
+
+	decoded := reflect.New(typ)
+	if err := json.Unmarshal(data, decoded.Interface()); err != nil {
+		t.Fatalf("%s: failed to decode %s: %v", typ.Name(), data, err)
+	}
+	for fieldName, isNull := range nullable {
+		if got := decoded.Elem().FieldByName(fieldName).IsNil(); got != isNull {
+			t.Errorf("%s.%s: expected nil to be %v, got %v (payload %s)", typ.Name(), fieldName, isNull, got, data)
+		}
+	}
+}
+
+func FuzzNullableFields(f *testing.F) {
+	f.Add(uint64(0))
+	f.Add(^uint64(0))
+	f.Add(uint64(0xAAAAAAAAAAAAAAAA))
+	f.Add(uint64(0x5555555555555555))
+
+	f.Fuzz(func(t *testing.T, mask uint64) {
+		for _, target := range decodeTargets() {
+			checkNullableFields(t, target, mask)
+		}
+	})
+}
+
+func FuzzDecodeJSON(f *testing.F) {
+	// Seed corpus for the types with the most nullable and deprecated fields.
+	f.Add([]byte(`{"id": 1, "date": "2023-01-01", "asset_class": "stock", "asset_subclass": null, "ticker_code": "7203", "ticker": null, "name_raw": null, "name_clean": "Toyota", "currency": "JPY", "tax_type": ["NISA"], "tax_sub_type": null, "market_value": 1.0000005e7, "value": 0, "acquisition_value": null, "cost_basis": null, "profit": -1200.5, "quantity": null}`))
+	f.Add([]byte(`{"id": 1, "account_key": "key", "account_group": 2, "account_type": "bank", "currency": "JPY", "institution_account_number": null, "branch_name": null, "last_aggregated_success": null, "current_balance": null, "current_balance_in_base": 0, "account_attributes": {}}`))
+	f.Add([]byte(`{"account_attributes": null, "tax_type": null}`))
+	f.Add([]byte(`{"current_balance": "not a number"}`))
+	f.Add([]byte(`[]`))
+	f.Add([]byte(`null`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Decoding arbitrary input must never panic; errors are expected for invalid payloads.
+		for _, target := range decodeTargets() {
+			decoded := reflect.New(reflect.TypeOf(target)).Interface()
+			_ = json.Unmarshal(data, decoded)
+		}
+	})
+}
+
+func TestDecodeScientificNotation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		data     string
+		expected float64
+	}{
+		{name: "success case: positive exponent", data: `{"balance": 1.0000005e7}`, expected: 10000005},
+		{name: "success case: upper case exponent with sign", data: `{"balance": 1.5E+3}`, expected: 1500},
+		{name: "success case: negative exponent", data: `{"balance": -2.5e-1}`, expected: -0.25},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var account PersonalAccount
+			if err := json.Unmarshal([]byte(tt.data), &account); err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+			if account.Balance == nil {
+				t.Fatal("expected balance, got nil")
+			}
+			if *account.Balance != tt.expected {
+				t.Errorf("expected balance %v, got %v", tt.expected, *account.Balance)
+			}
+		})
+	}
+}
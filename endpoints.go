@@ -0,0 +1,72 @@
+package moneytree
+
+import "net/http"
+
+// EndpointInfo describes the Moneytree LINK API endpoint called by a method of Client,
+// for tools that generate CLIs or documentation around this package.
+type EndpointInfo struct {
+	// Name is the name of the Client method that calls the endpoint, e.g. "GetPersonalAccountTransactions".
+	Name string
+	// Method is the HTTP method of the request, e.g. http.MethodGet.
+	Method string
+	// PathTemplate is the request path relative to the BaseURL, with the path parameters in braces,
+	// e.g. "link/accounts/{account_id}/transactions.json".
+	PathTemplate string
+	// QueryParams lists the query parameters the method sends when the corresponding options are specified.
+	// It is nil if the method sends no query parameters.
+	QueryParams []string
+}
+
+// Endpoints returns a description of every endpoint called by the single-request methods of Client,
+// in the order of the API reference. Helpers that combine several requests, such as AllAccounts
+// or GetTransactionsByDateRange, are not listed. The returned slice is newly allocated on every call
+// and may be modified.
+//
+// Example:
+//
+//	for _, endpoint := range moneytree.Endpoints() {
+//		fmt.Printf("%s %s %s %v\n", endpoint.Name, endpoint.Method, endpoint.PathTemplate, endpoint.QueryParams)
+//	}
+func Endpoints() []EndpointInfo {
+	pagination := func(params ...string) []string {
+		return append([]string{"page", "per_page"}, params...)
+	}
+	return []EndpointInfo{
+		{Name: "RetrieveToken", Method: http.MethodPost, PathTemplate: "oauth/token"},
+		{Name: "RevokeToken", Method: http.MethodPost, PathTemplate: "oauth/revoke"},
+		{Name: "GetProfile", Method: http.MethodGet, PathTemplate: "link/profile.json"},
+		{Name: "RevokeProfile", Method: http.MethodPost, PathTemplate: "link/profile/revoke.json"},
+		{Name: "GetAccountGroups", Method: http.MethodGet, PathTemplate: "link/profile/account_groups.json"},
+		{Name: "RefreshProfile", Method: http.MethodPost, PathTemplate: "link/profile/refresh.json"},
+		{Name: "RefreshAccountGroup", Method: http.MethodPost, PathTemplate: "link/account_groups/{account_group}/refresh.json"},
+		{Name: "GetInstitutions", Method: http.MethodGet, PathTemplate: "link/institutions.json", QueryParams: []string{"since"}},
+		{Name: "GetCategories", Method: http.MethodGet, PathTemplate: "link/categories.json", QueryParams: []string{"page", "locale"}},
+		{Name: "CreateCategory", Method: http.MethodPost, PathTemplate: "link/categories.json"},
+		{Name: "GetSystemCategories", Method: http.MethodGet, PathTemplate: "link/categories/system.json", QueryParams: []string{"page", "locale"}},
+		{Name: "GetCategory", Method: http.MethodGet, PathTemplate: "link/categories/{category_id}.json"},
+		{Name: "UpdateCategory", Method: http.MethodPut, PathTemplate: "link/categories/{category_id}.json"},
+		{Name: "DeleteCategory", Method: http.MethodDelete, PathTemplate: "link/categories/{category_id}.json"},
+		{Name: "GetPersonalAccounts", Method: http.MethodGet, PathTemplate: "link/accounts.json", QueryParams: pagination()},
+		{Name: "GetPersonalAccount", Method: http.MethodGet, PathTemplate: "link/accounts/{account_id}.json"},
+		{Name: "GetPersonalAccountBalances", Method: http.MethodGet, PathTemplate: "link/accounts/{account_id}/balances.json", QueryParams: pagination("since", "start_date", "end_date")},
+		{Name: "GetAccountBalanceDetails", Method: http.MethodGet, PathTemplate: "link/accounts/{account_id}/balances/details.json"},
+		{Name: "GetAccountDueBalances", Method: http.MethodGet, PathTemplate: "link/accounts/{account_id}/due_balances.json", QueryParams: []string{"page", "since", "start_date", "end_date"}},
+		{Name: "GetTermDeposits", Method: http.MethodGet, PathTemplate: "link/accounts/{account_id}/term_deposits.json", QueryParams: []string{"page"}},
+		{Name: "GetPersonalAccountTransactions", Method: http.MethodGet, PathTemplate: "link/accounts/{account_id}/transactions.json", QueryParams: pagination("sort_key", "sort_by", "since")},
+		{Name: "UpdatePersonalAccountTransaction", Method: http.MethodPut, PathTemplate: "link/accounts/{account_id}/transactions/{transaction_id}.json"},
+		{Name: "SubmitAccount2FA", Method: http.MethodPut, PathTemplate: "link/accounts/{account_id}/2fa.json"},
+		{Name: "GetAccountCaptcha", Method: http.MethodGet, PathTemplate: "link/accounts/{account_id}/captcha.json"},
+		{Name: "GetCorporateAccounts", Method: http.MethodGet, PathTemplate: "link/corporate/accounts.json", QueryParams: []string{"page"}},
+		{Name: "GetCorporateAccountBalances", Method: http.MethodGet, PathTemplate: "link/corporate/accounts/{account_id}/balances.json", QueryParams: pagination("sort_key", "sort_by", "since", "start_date", "end_date")},
+		{Name: "GetCorporateAccountTransactions", Method: http.MethodGet, PathTemplate: "link/corporate/accounts/{account_id}/transactions.json", QueryParams: pagination("sort_key", "sort_by", "since")},
+		{Name: "UpdateCorporateAccountTransaction", Method: http.MethodPut, PathTemplate: "link/corporate/accounts/{account_id}/transactions/{transaction_id}.json"},
+		{Name: "GetInvestmentAccounts", Method: http.MethodGet, PathTemplate: "link/investments/accounts.json", QueryParams: []string{"page"}},
+		{Name: "GetInvestmentAccount", Method: http.MethodGet, PathTemplate: "link/investments/accounts/{account_id}.json"},
+		{Name: "GetInvestmentPositions", Method: http.MethodGet, PathTemplate: "link/investments/accounts/{account_id}/positions.json", QueryParams: []string{"page"}},
+		{Name: "GetInvestmentAccountTransactions", Method: http.MethodGet, PathTemplate: "link/investments/accounts/{account_id}/transactions.json", QueryParams: pagination("sort_key", "sort_by", "since")},
+		{Name: "UpdateInvestmentAccountTransaction", Method: http.MethodPut, PathTemplate: "link/investments/accounts/{account_id}/transactions/{transaction_id}.json"},
+		{Name: "GetPointAccounts", Method: http.MethodGet, PathTemplate: "link/points/accounts.json", QueryParams: pagination()},
+		{Name: "GetPointAccountTransactions", Method: http.MethodGet, PathTemplate: "link/points/accounts/{account_id}/transactions.json", QueryParams: pagination("sort_key", "sort_by", "since")},
+		{Name: "GetPointExpirations", Method: http.MethodGet, PathTemplate: "link/points/accounts/{account_id}/expirations.json", QueryParams: pagination("since")},
+	}
+}
@@ -0,0 +1,309 @@
+package moneytree
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestEndpoints(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: every descriptor names a unique Client method", func(t *testing.T) {
+		t.Parallel()
+
+		clientType := reflect.TypeOf(&Client{})
+		seen := make(map[string]bool)
+		for _, endpoint := range Endpoints() {
+			if _, ok := clientType.MethodByName(endpoint.Name); !ok {
+				t.Errorf("%s is not a method of Client", endpoint.Name)
+			}
+			if seen[endpoint.Name] {
+				t.Errorf("%s is described twice", endpoint.Name)
+			}
+			seen[endpoint.Name] = true
+		}
+	})
+
+	t.Run("success case: the GetPersonalAccountTransactions descriptor matches its request", func(t *testing.T) {
+		t.Parallel()
+
+		var got *http.Request
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = r
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"transactions": []}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+		setTestToken(client, "test-access-token")
+
+		_, err = client.GetPersonalAccountTransactions(context.Background(), "account_key_123",
+			WithPageForTransactions(2),
+			WithPerPageForTransactions(100),
+			WithSortKeyForTransactions("date"),
+			WithSortByForTransactions("desc"),
+			WithSinceForTransactions("2023-01-01"),
+		)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+
+		index := slices.IndexFunc(Endpoints(), func(e EndpointInfo) bool { return e.Name == "GetPersonalAccountTransactions" })
+		if index < 0 {
+			t.Fatal("expected a descriptor for GetPersonalAccountTransactions")
+		}
+		endpoint := Endpoints()[index]
+
+		if got.Method != endpoint.Method {
+			t.Errorf("expected method %s, got %s", endpoint.Method, got.Method)
+		}
+		expectedPath := "/" + strings.ReplaceAll(endpoint.PathTemplate, "{account_id}", "account_key_123")
+		if got.URL.Path != expectedPath {
+			t.Errorf("expected path %s, got %s", expectedPath, got.URL.Path)
+		}
+		var params []string
+		for key := range got.URL.Query() {
+			params = append(params, key)
+		}
+		slices.Sort(params)
+		expectedParams := slices.Sorted(slices.Values(endpoint.QueryParams))
+		if !slices.Equal(params, expectedParams) {
+			t.Errorf("expected query parameters %v, got %v", expectedParams, params)
+		}
+	})
+
+	t.Run("success case: every descriptor matches the request of its method", func(t *testing.T) {
+		t.Parallel()
+
+		type request struct {
+			method string
+			path   string
+			params []string
+		}
+		var got request
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = request{method: r.Method, path: r.URL.Path}
+			for key := range r.URL.Query() {
+				got.params = append(got.params, key)
+			}
+			slices.Sort(got.params)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		// Validation is skipped so that every option can be set at once.
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL:              baseURL,
+				SkipClientValidation: true,
+			},
+		}
+		setTestToken(client, "test-access-token")
+
+		ctx := context.Background()
+		const accountKey = "account_key_123"
+		calls := map[string]func() error{
+			"RetrieveToken": func() error {
+				_, err := client.RetrieveToken(ctx, &RetrieveTokenRequest{GrantType: StringPtr("authorization_code")})
+				return err
+			},
+			"RevokeToken": func() error {
+				return client.RevokeToken(ctx, &RevokeTokenRequest{Token: "token"})
+			},
+			"GetProfile": func() error {
+				_, err := client.GetProfile(ctx)
+				return err
+			},
+			"RevokeProfile": func() error {
+				return client.RevokeProfile(ctx)
+			},
+			"GetAccountGroups": func() error {
+				_, err := client.GetAccountGroups(ctx)
+				return err
+			},
+			"RefreshProfile": func() error {
+				return client.RefreshProfile(ctx)
+			},
+			"RefreshAccountGroup": func() error {
+				return client.RefreshAccountGroup(ctx, 1)
+			},
+			"GetInstitutions": func() error {
+				_, err := client.GetInstitutions(ctx, WithSince("2023-01-01"))
+				return err
+			},
+			"GetCategories": func() error {
+				_, err := client.GetCategories(ctx, WithPageForCategories(1), WithLocale("ja"))
+				return err
+			},
+			"CreateCategory": func() error {
+				_, err := client.CreateCategory(ctx, &CreateCategoryRequest{Name: "name"})
+				return err
+			},
+			"GetSystemCategories": func() error {
+				_, err := client.GetSystemCategories(ctx, WithPageForCategories(1), WithLocale("ja"))
+				return err
+			},
+			"GetCategory": func() error {
+				_, err := client.GetCategory(ctx, 1)
+				return err
+			},
+			"UpdateCategory": func() error {
+				_, err := client.UpdateCategory(ctx, 1, &UpdateCategoryRequest{Name: "name"})
+				return err
+			},
+			"DeleteCategory": func() error {
+				return client.DeleteCategory(ctx, 1)
+			},
+			"GetPersonalAccounts": func() error {
+				_, err := client.GetPersonalAccounts(ctx, WithPage(1), WithPerPage(10))
+				return err
+			},
+			"GetPersonalAccount": func() error {
+				_, err := client.GetPersonalAccount(ctx, accountKey)
+				return err
+			},
+			"GetPersonalAccountBalances": func() error {
+				_, err := client.GetPersonalAccountBalances(ctx, accountKey, WithPageForBalances(1), WithPerPageForBalances(10),
+					WithSinceForBalances("2023-01-01"), WithStartDateForBalances("2023-01-01"), WithEndDateForBalances("2023-02-01"))
+				return err
+			},
+			"GetAccountBalanceDetails": func() error {
+				_, err := client.GetAccountBalanceDetails(ctx, accountKey)
+				return err
+			},
+			"GetAccountDueBalances": func() error {
+				_, err := client.GetAccountDueBalances(ctx, accountKey, WithPageForDueBalances(1), WithSinceForDueBalances("2023-01-01"),
+					WithStartDateForDueBalances("2023-01-01"), WithEndDateForDueBalances("2023-02-01"))
+				return err
+			},
+			"GetTermDeposits": func() error {
+				_, err := client.GetTermDeposits(ctx, accountKey, WithPageForTermDeposits(1))
+				return err
+			},
+			"GetPersonalAccountTransactions": func() error {
+				_, err := client.GetPersonalAccountTransactions(ctx, accountKey, WithPageForTransactions(1), WithPerPageForTransactions(10),
+					WithSortKeyForTransactions("date"), WithSortByForTransactions("desc"), WithSinceForTransactions("2023-01-01"))
+				return err
+			},
+			"UpdatePersonalAccountTransaction": func() error {
+				_, err := client.UpdatePersonalAccountTransaction(ctx, accountKey, 1, &UpdatePersonalAccountTransactionRequest{})
+				return err
+			},
+			"SubmitAccount2FA": func() error {
+				return client.SubmitAccount2FA(ctx, accountKey, &SubmitAccount2FARequest{KeyValues: SubmitAccount2FAKeyValues{OTP: StringPtr("123456")}})
+			},
+			"GetAccountCaptcha": func() error {
+				_, err := client.GetAccountCaptcha(ctx, accountKey)
+				return err
+			},
+			"GetCorporateAccounts": func() error {
+				_, err := client.GetCorporateAccounts(ctx, WithPageForCorporateAccounts(1))
+				return err
+			},
+			"GetCorporateAccountBalances": func() error {
+				_, err := client.GetCorporateAccountBalances(ctx, accountKey, WithPageForCorporateBalances(1), WithPerPageForCorporateBalances(10),
+					WithSortKeyForCorporateBalances("date"), WithSortByForCorporateBalances("desc"),
+					WithSinceForCorporateBalances("2023-01-01"), WithStartDateForCorporateBalances("2023-01-01"),
+					WithEndDateForCorporateBalances("2023-02-01"))
+				return err
+			},
+			"GetCorporateAccountTransactions": func() error {
+				_, err := client.GetCorporateAccountTransactions(ctx, accountKey, WithPageForCorporateTransactions(1), WithPerPageForCorporateTransactions(10),
+					WithSortKeyForCorporateTransactions("date"), WithSortByForCorporateTransactions("desc"), WithSinceForCorporateTransactions("2023-01-01"))
+				return err
+			},
+			"UpdateCorporateAccountTransaction": func() error {
+				_, err := client.UpdateCorporateAccountTransaction(ctx, accountKey, 1, &UpdateCorporateAccountTransactionRequest{})
+				return err
+			},
+			"GetInvestmentAccounts": func() error {
+				_, err := client.GetInvestmentAccounts(ctx, WithPageForInvestmentAccounts(1))
+				return err
+			},
+			"GetInvestmentAccount": func() error {
+				_, err := client.GetInvestmentAccount(ctx, accountKey)
+				return err
+			},
+			"GetInvestmentPositions": func() error {
+				_, err := client.GetInvestmentPositions(ctx, accountKey, WithPageForInvestmentPositions(1))
+				return err
+			},
+			"GetInvestmentAccountTransactions": func() error {
+				_, err := client.GetInvestmentAccountTransactions(ctx, accountKey, WithPageForInvestmentTransactions(1), WithPerPageForInvestmentTransactions(10),
+					WithSortKeyForInvestmentTransactions("date"), WithSortByForInvestmentTransactions("desc"), WithSinceForInvestmentTransactions("2023-01-01"))
+				return err
+			},
+			"UpdateInvestmentAccountTransaction": func() error {
+				_, err := client.UpdateInvestmentAccountTransaction(ctx, accountKey, 1, &UpdateInvestmentAccountTransactionRequest{})
+				return err
+			},
+			"GetPointAccounts": func() error {
+				_, err := client.GetPointAccounts(ctx, WithPageForPointAccounts(1), WithPerPageForPointAccounts(10))
+				return err
+			},
+			"GetPointAccountTransactions": func() error {
+				_, err := client.GetPointAccountTransactions(ctx, 123, WithPageForPointAccountTransactions(1), WithPerPageForPointAccountTransactions(10),
+					WithSortKeyForPointAccountTransactions("date"), WithSortByForPointAccountTransactions("desc"), WithSinceForPointAccountTransactions("2023-01-01"))
+				return err
+			},
+			"GetPointExpirations": func() error {
+				_, err := client.GetPointExpirations(ctx, 123, WithPageForPointExpirations(1), WithPerPageForPointExpirations(10),
+					WithSinceForPointExpirations("2023-01-01"))
+				return err
+			},
+		}
+
+		replacer := strings.NewReplacer(
+			"{account_id}", accountKey,
+			"{account_group}", "1",
+			"{category_id}", "1",
+			"{transaction_id}", "1",
+		)
+		for _, endpoint := range Endpoints() {
+			call, ok := calls[endpoint.Name]
+			if !ok {
+				t.Errorf("%s: no call to compare the descriptor with", endpoint.Name)
+				continue
+			}
+			got = request{}
+			if err := call(); err != nil {
+				t.Errorf("%s: expected nil, got %v", endpoint.Name, err)
+				continue
+			}
+
+			path := "/" + replacer.Replace(endpoint.PathTemplate)
+			if strings.HasPrefix(endpoint.Name, "GetPoint") {
+				path = strings.ReplaceAll(path, accountKey, "123")
+			}
+			expectedParams := slices.Sorted(slices.Values(endpoint.QueryParams))
+			if got.method != endpoint.Method || got.path != path || !slices.Equal(got.params, expectedParams) {
+				t.Errorf("%s: expected %s %s %v, got %s %s %v", endpoint.Name,
+					endpoint.Method, path, expectedParams, got.method, got.path, got.params)
+			}
+		}
+	})
+}
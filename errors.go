@@ -1,15 +1,63 @@
 package moneytree
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
+	"net/url"
+	"strings"
 )
 
 var errNonNilContext = errors.New("context must be non-nil")
 
+// ErrEmptyResponseBody is returned when the API responds with a successful status
+// but an empty body for a call that expects a JSON document.
+// The returned error wraps ErrEmptyResponseBody together with the request path,
+// so use errors.Is to detect it.
+var ErrEmptyResponseBody = errors.New("empty response body")
+
+// ErrTruncatedResponse is returned when the connection was lost while the body of a successful
+// response was being read, e.g. because a proxy dropped it. It indicates a network failure rather
+// than malformed data from the API. GET requests are retried according to the RetryConfig of
+// the Client before it is returned. The returned error wraps ErrTruncatedResponse together with
+// the request path and the underlying read error (often io.ErrUnexpectedEOF), so use errors.Is to detect it.
+var ErrTruncatedResponse = errors.New("response body was truncated")
+
+// ErrMaxResponseTime is returned when a response, including its body, was not fully received within
+// the time set by WithMaxResponseTime, e.g. because the server sends the body a few bytes at a time.
+// The request is not retried. The returned error wraps ErrMaxResponseTime together with the request path,
+// so use errors.Is to detect it.
+var ErrMaxResponseTime = errors.New("response exceeded the maximum response time")
+
+// ErrClientNotConfigured is returned by every method of a Client that was not created by NewClient,
+// such as the zero value &Client{}, instead of panicking. Create clients with NewClient: struct
+// literals are not supported, as the fields of Client are unexported.
+var ErrClientNotConfigured = errors.New("client is not configured: create it with NewClient")
+
+// ErrNoToken is returned by every method that calls an authenticated endpoint when the Client
+// has no valid access token and no refresh token to obtain one, i.e. when SetToken has not been
+// called, or when the token has expired and cannot be refreshed. Call SetToken with a token
+// obtained from RetrieveToken, or pass a token for a single call with WithAccessToken.
+var ErrNoToken = errors.New("no access token and no refresh token configured: call SetToken with a token obtained from RetrieveToken")
+
+// ErrNotFound matches an APIError for a 404 Not Found response, whichever endpoint produced it.
+// Use errors.Is to detect it; the *APIError itself is still available through errors.As.
+//
+// Example:
+//
+//	category, err := client.GetCategory(ctx, categoryID)
+//	if errors.Is(err, moneytree.ErrNotFound) {
+//		// The category does not exist or was deleted.
+//	}
+var ErrNotFound = errors.New("not found")
+
+// ErrCurrencyMismatch is returned by the arithmetic methods of Money when the operands are in different currencies.
+var ErrCurrencyMismatch = errors.New("currency mismatch")
+
 // APIError represents an error returned by the Moneytree LINK API.
 type APIError struct {
 	StatusCode int `json:"-"`
@@ -19,9 +67,140 @@ type APIError struct {
 	// ErrorDescription is the value of the error_description field set by moneytree.
 	// However, if an unexpected error occurs during response decoding, it contains a message set by the library.
 	ErrorDescription string `json:"error_description,omitempty"`
-	RawMessage       string `json:"-"`
+	// RawMessage is the response body as is, e.g. to log the full payload
+	// when it does not have the error and error_description members.
+	RawMessage string `json:"-"`
+	// RequestID is the value of the RequestIDHeader of the response, which identifies the request
+	// in a support ticket. It is empty if the API did not send the header.
+	RequestID string `json:"-"`
+	// Header holds the headers of the response, such as the rate limit headers.
+	Header http.Header `json:"-"`
+	// ProblemType is the "type" member of an RFC 7807 problem details response.
+	// It is only set when the response Content-Type is application/problem+json.
+	ProblemType string `json:"-"`
+	// ProblemTitle is the "title" member of an RFC 7807 problem details response.
+	// It is only set when the response Content-Type is application/problem+json.
+	ProblemTitle string `json:"-"`
+	// ProblemDetail is the "detail" member of an RFC 7807 problem details response.
+	// It is only set when the response Content-Type is application/problem+json.
+	ProblemDetail string `json:"-"`
+	// RequiredScopes lists the OAuth scopes the API reported as required for a 403 Forbidden response.
+	// It is taken from the required_scopes or scope member of the error body, or from the scope
+	// parameter of the WWW-Authenticate header. It is nil if the API did not report any scopes.
+	// Use it to ask the user to consent to the missing scopes again.
+	RequiredScopes []string `json:"-"`
+}
+
+// IsNotFound reports whether the API responded with 404 Not Found.
+func (e *APIError) IsNotFound() bool {
+	return e.StatusCode == http.StatusNotFound
+}
+
+// Is reports whether the APIError matches target, so that errors.Is(err, ErrNotFound)
+// holds for a 404 Not Found response.
+func (e *APIError) Is(target error) bool {
+	return target == ErrNotFound && e.IsNotFound()
+}
+
+// IsUnauthorized reports whether the API responded with 401 Unauthorized,
+// which means the access token is invalid, expired or revoked.
+func (e *APIError) IsUnauthorized() bool {
+	return e.StatusCode == http.StatusUnauthorized
+}
+
+// IsForbidden reports whether the API responded with 403 Forbidden,
+// which usually means the access token lacks a required scope. See RequiredScopes.
+//
+// Example:
+//
+//	var apiErr *moneytree.APIError
+//	if errors.As(err, &apiErr) && apiErr.IsForbidden() {
+//		fmt.Printf("missing scopes: %v\n", apiErr.RequiredScopes)
+//	}
+func (e *APIError) IsForbidden() bool {
+	return e.StatusCode == http.StatusForbidden
+}
+
+// HTTPStatusForClient returns the HTTP status that a service proxying Moneytree data through its own
+// API should return to its client for this error. Statuses that describe the request or the guest,
+// 400, 401, 403, 404, 409, 422 and 429, are forwarded as is. Other statuses describe a problem between
+// the service and Moneytree that its client cannot fix, so they map to 502 Bad Gateway, as do 5xx statuses.
+//
+// Example:
+//
+//	var apiErr *moneytree.APIError
+//	if errors.As(err, &apiErr) {
+//		http.Error(w, http.StatusText(apiErr.HTTPStatusForClient()), apiErr.HTTPStatusForClient())
+//	}
+func (e *APIError) HTTPStatusForClient() int {
+	switch e.StatusCode {
+	case http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound,
+		http.StatusConflict, http.StatusUnprocessableEntity, http.StatusTooManyRequests:
+		return e.StatusCode
+	default:
+		return http.StatusBadGateway
+	}
+}
+
+// HTTPStatusForClient returns the HTTP status that a service proxying Moneytree data through its own
+// API should return to its client for err, any error returned by a method of Client:
+//
+//   - nil yields 200 OK.
+//   - An *APIError yields the status of APIError.HTTPStatusForClient.
+//   - ErrNoToken yields 401 Unauthorized, as the guest has to authorize the service again.
+//   - context.DeadlineExceeded and ErrMaxResponseTime yield 504 Gateway Timeout.
+//   - ErrTruncatedResponse, ErrEmptyResponseBody and network errors (*url.Error) yield 502 Bad Gateway.
+//   - Any other error, such as an invalid option, yields 500 Internal Server Error.
+//
+// Example:
+//
+//	accounts, err := client.GetPersonalAccounts(ctx)
+//	if err != nil {
+//		status := moneytree.HTTPStatusForClient(err)
+//		http.Error(w, http.StatusText(status), status)
+//		return
+//	}
+func HTTPStatusForClient(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.HTTPStatusForClient()
+	}
+	var urlErr *url.Error
+	switch {
+	case errors.Is(err, ErrNoToken):
+		return http.StatusUnauthorized
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, ErrMaxResponseTime):
+		return http.StatusGatewayTimeout
+	case errors.Is(err, ErrTruncatedResponse), errors.Is(err, ErrEmptyResponseBody), errors.As(err, &urlErr):
+		return http.StatusBadGateway
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// problemDetails represents an RFC 7807 problem details response body.
+type problemDetails struct {
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Detail string `json:"detail"`
 }
 
+// scopeHints represents the members of an error body that describe the required OAuth scopes.
+type scopeHints struct {
+	RequiredScopes []string `json:"required_scopes"`
+	// Scope is a space-delimited list of scopes, as in RFC 6750.
+	Scope string `json:"scope"`
+}
+
+// problemJSONMediaType is the media type of RFC 7807 problem details responses.
+const problemJSONMediaType = "application/problem+json"
+
+// RequestIDHeader is the response header from which APIError.RequestID is taken.
+const RequestIDHeader = "X-Request-Id"
+
 // Error implements the error interface.
 func (e *APIError) Error() string {
 	if e.ErrorDescription != "" {
@@ -48,6 +227,8 @@ func checkResponseError(r *http.Response) error {
 
 	apiErr := &APIError{
 		StatusCode: r.StatusCode,
+		RequestID:  r.Header.Get(RequestIDHeader),
+		Header:     r.Header.Clone(),
 	}
 
 	if r.Body != nil {
@@ -56,6 +237,8 @@ func checkResponseError(r *http.Response) error {
 			return &APIError{
 				StatusCode:       r.StatusCode,
 				ErrorDescription: fmt.Sprintf("unable to read response from moneytree: %s", err.Error()),
+				RequestID:        apiErr.RequestID,
+				Header:           apiErr.Header,
 			}
 		}
 
@@ -66,12 +249,85 @@ func checkResponseError(r *http.Response) error {
 				StatusCode:       r.StatusCode,
 				ErrorDescription: fmt.Sprintf("unable to decode response from moneytree: %s", err.Error()),
 				RawMessage:       string(body),
+				RequestID:        apiErr.RequestID,
+				Header:           apiErr.Header,
+			}
+		}
+
+		if isProblemJSON(r.Header.Get("Content-Type")) {
+			var problem problemDetails
+			// The body is valid JSON, but its members may not have the expected types, e.g. a title
+			// given as an object. The problem details are then ignored rather than trusted half-decoded.
+			if err := json.Unmarshal(body, &problem); err == nil {
+				apiErr.ProblemType = problem.Type
+				apiErr.ProblemTitle = problem.Title
+				apiErr.ProblemDetail = problem.Detail
+				if apiErr.ErrorDescription == "" {
+					if problem.Detail != "" {
+						apiErr.ErrorDescription = problem.Detail
+					} else {
+						apiErr.ErrorDescription = problem.Title
+					}
+				}
+			}
+		}
+
+		if apiErr.IsForbidden() {
+			var hints scopeHints
+			// The body is valid JSON, but its members may not have the expected types, e.g. a scope
+			// given as an array. The hints are then ignored rather than trusted half-decoded, and the
+			// scopes are taken from the WWW-Authenticate header below, if any.
+			if err := json.Unmarshal(body, &hints); err == nil {
+				apiErr.RequiredScopes = hints.RequiredScopes
+				if len(apiErr.RequiredScopes) == 0 {
+					apiErr.RequiredScopes = splitScopes(hints.Scope)
+				}
 			}
 		}
 	}
+
+	if apiErr.IsForbidden() && len(apiErr.RequiredScopes) == 0 {
+		apiErr.RequiredScopes = splitScopes(authenticateParam(r.Header.Get("WWW-Authenticate"), "scope"))
+	}
 	return apiErr
 }
 
+// splitScopes splits a space-delimited scope list. It returns nil for an empty list.
+func splitScopes(scope string) []string {
+	scopes := strings.Fields(scope)
+	if len(scopes) == 0 {
+		return nil
+	}
+	return scopes
+}
+
+// authenticateParam returns the value of the named auth-param in a WWW-Authenticate header
+// such as `Bearer error="insufficient_scope", scope="accounts_read transactions_read"`.
+func authenticateParam(header, name string) string {
+	// Skip the auth-scheme.
+	_, params, ok := strings.Cut(strings.TrimSpace(header), " ")
+	if !ok {
+		return ""
+	}
+	for _, param := range strings.Split(params, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+		if ok && strings.EqualFold(strings.TrimSpace(key), name) {
+			return strings.Trim(strings.TrimSpace(value), `"`)
+		}
+	}
+	return ""
+}
+
+// isProblemJSON reports whether the Content-Type header denotes an RFC 7807 problem details body.
+func isProblemJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == problemJSONMediaType
+}
+
+// isErrorStatusCode reports whether statusCode is a client or server error.
 func isErrorStatusCode(statusCode int) bool {
-	return statusCode >= http.StatusBadRequest && statusCode < http.StatusInternalServerError
+	return statusCode >= http.StatusBadRequest
 }
@@ -3,8 +3,10 @@ package moneytree
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 )
 
@@ -110,7 +112,7 @@ func TestCheckResponseError(t *testing.T) {
 		}
 	})
 
-	t.Run("正常系: ステータスコード500の場合はnilを返す（400-499の範囲のみがエラー）", func(t *testing.T) {
+	t.Run("異常系: ステータスコード500の場合はAPIErrorを返す", func(t *testing.T) {
 		t.Parallel()
 
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -132,8 +134,12 @@ func TestCheckResponseError(t *testing.T) {
 		}()
 
 		err = checkResponseError(resp)
-		if err != nil {
-			t.Errorf("expected nil, got %v", err)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected APIError, got %T", err)
+		}
+		if apiErr.StatusCode != http.StatusInternalServerError {
+			t.Errorf("expected status code %d, got %d", http.StatusInternalServerError, apiErr.StatusCode)
 		}
 	})
 
@@ -171,4 +177,535 @@ func TestCheckResponseError(t *testing.T) {
 			t.Errorf("expected raw message 'invalid json', got %s", apiErr.RawMessage)
 		}
 	})
+
+	t.Run("エラーケース: application/problem+jsonの場合、type/title/detailを抽出する", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/problem+json; charset=utf-8")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"type": "https://example.com/probs/invalid-param", "title": "Invalid parameter", "detail": "since must be a date"}`))
+		}))
+		defer server.Close()
+
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("failed to make request: %v", err)
+		}
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+
+		err = checkResponseError(resp)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected APIError, got %T", err)
+		}
+		if apiErr.ProblemType != "https://example.com/probs/invalid-param" {
+			t.Errorf("expected problem type %q, got %q", "https://example.com/probs/invalid-param", apiErr.ProblemType)
+		}
+		if apiErr.ProblemTitle != "Invalid parameter" {
+			t.Errorf("expected problem title %q, got %q", "Invalid parameter", apiErr.ProblemTitle)
+		}
+		if apiErr.ProblemDetail != "since must be a date" {
+			t.Errorf("expected problem detail %q, got %q", "since must be a date", apiErr.ProblemDetail)
+		}
+		if apiErr.ErrorDescription != "since must be a date" {
+			t.Errorf("expected error description %q, got %q", "since must be a date", apiErr.ErrorDescription)
+		}
+		if apiErr.Error() != "400: since must be a date" {
+			t.Errorf("expected error message %q, got %q", "400: since must be a date", apiErr.Error())
+		}
+	})
+
+	t.Run("エラーケース: problem+jsonにdetailがない場合、titleをErrorDescriptionに設定する", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/problem+json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"title": "Not Found"}`))
+		}))
+		defer server.Close()
+
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("failed to make request: %v", err)
+		}
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+
+		err = checkResponseError(resp)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected APIError, got %T", err)
+		}
+		if apiErr.ErrorDescription != "Not Found" {
+			t.Errorf("expected error description %q, got %q", "Not Found", apiErr.ErrorDescription)
+		}
+	})
+
+	t.Run("エラーケース: problem+jsonのメンバーの型が想定外の場合、problem+jsonのフィールドは設定されない", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/problem+json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"type": "https://example.com/probs/invalid", "title": {"en": "Invalid"}, "detail": "half decoded"}`))
+		}))
+		defer server.Close()
+
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("failed to make request: %v", err)
+		}
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+
+		err = checkResponseError(resp)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected APIError, got %T", err)
+		}
+		if apiErr.ProblemType != "" || apiErr.ProblemTitle != "" || apiErr.ProblemDetail != "" {
+			t.Errorf("expected empty problem fields, got %q, %q, %q", apiErr.ProblemType, apiErr.ProblemTitle, apiErr.ProblemDetail)
+		}
+		if apiErr.ErrorDescription != "" {
+			t.Errorf("expected empty error description, got %q", apiErr.ErrorDescription)
+		}
+	})
+
+	t.Run("エラーケース: application/jsonの場合、problem+jsonのフィールドは設定されない", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error": "invalid_request", "title": "ignored"}`))
+		}))
+		defer server.Close()
+
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("failed to make request: %v", err)
+		}
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+
+		err = checkResponseError(resp)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected APIError, got %T", err)
+		}
+		if apiErr.ProblemTitle != "" {
+			t.Errorf("expected empty problem title, got %q", apiErr.ProblemTitle)
+		}
+		if apiErr.ErrorType != "invalid_request" {
+			t.Errorf("expected error type %q, got %q", "invalid_request", apiErr.ErrorType)
+		}
+	})
+
+	t.Run("エラーケース: ステータスコード403でrequired_scopesがある場合、RequiredScopesに設定する", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error": "insufficient_scope", "error_description": "The access token does not have the required scopes.", "required_scopes": ["accounts_read", "transactions_read"]}`))
+		}))
+		defer server.Close()
+
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("failed to make request: %v", err)
+		}
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+
+		err = checkResponseError(resp)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected APIError, got %T", err)
+		}
+		if !apiErr.IsForbidden() {
+			t.Error("expected IsForbidden to be true")
+		}
+		expectedScopes := []string{"accounts_read", "transactions_read"}
+		if len(apiErr.RequiredScopes) != len(expectedScopes) {
+			t.Fatalf("expected required scopes %v, got %v", expectedScopes, apiErr.RequiredScopes)
+		}
+		for i := range expectedScopes {
+			if apiErr.RequiredScopes[i] != expectedScopes[i] {
+				t.Errorf("expected required scopes %v, got %v", expectedScopes, apiErr.RequiredScopes)
+			}
+		}
+	})
+
+	t.Run("エラーケース: ステータスコード403でscopeの型が不正な場合、WWW-AuthenticateヘッダーのscopeをRequiredScopesに設定する", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("WWW-Authenticate", `Bearer error="insufficient_scope", scope="accounts_read"`)
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error": "insufficient_scope", "required_scopes": "transactions_read", "scope": ["transactions_read"]}`))
+		}))
+		defer server.Close()
+
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("failed to make request: %v", err)
+		}
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+
+		err = checkResponseError(resp)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected APIError, got %T", err)
+		}
+		if apiErr.ErrorType != "insufficient_scope" {
+			t.Errorf("expected error type %q, got %q", "insufficient_scope", apiErr.ErrorType)
+		}
+		if len(apiErr.RequiredScopes) != 1 || apiErr.RequiredScopes[0] != "accounts_read" {
+			t.Errorf("expected required scopes [accounts_read], got %v", apiErr.RequiredScopes)
+		}
+	})
+
+	t.Run("エラーケース: ステータスコード403でscopeがスペース区切りの場合、分割してRequiredScopesに設定する", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error": "insufficient_scope", "scope": "accounts_read  points_read"}`))
+		}))
+		defer server.Close()
+
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("failed to make request: %v", err)
+		}
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+
+		err = checkResponseError(resp)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected APIError, got %T", err)
+		}
+		if !apiErr.IsForbidden() {
+			t.Error("expected IsForbidden to be true")
+		}
+		expectedScopes := []string{"accounts_read", "points_read"}
+		if len(apiErr.RequiredScopes) != len(expectedScopes) {
+			t.Fatalf("expected required scopes %v, got %v", expectedScopes, apiErr.RequiredScopes)
+		}
+		for i := range expectedScopes {
+			if apiErr.RequiredScopes[i] != expectedScopes[i] {
+				t.Errorf("expected required scopes %v, got %v", expectedScopes, apiErr.RequiredScopes)
+			}
+		}
+	})
+
+	t.Run("エラーケース: ステータスコード403でボディにスコープがない場合、WWW-Authenticateヘッダーから取得する", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("WWW-Authenticate", `Bearer realm="moneytree", error="insufficient_scope", scope="investment_accounts_read"`)
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error": "insufficient_scope"}`))
+		}))
+		defer server.Close()
+
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("failed to make request: %v", err)
+		}
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+
+		err = checkResponseError(resp)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected APIError, got %T", err)
+		}
+		if !apiErr.IsForbidden() {
+			t.Error("expected IsForbidden to be true")
+		}
+		expectedScopes := []string{"investment_accounts_read"}
+		if len(apiErr.RequiredScopes) != len(expectedScopes) {
+			t.Fatalf("expected required scopes %v, got %v", expectedScopes, apiErr.RequiredScopes)
+		}
+		for i := range expectedScopes {
+			if apiErr.RequiredScopes[i] != expectedScopes[i] {
+				t.Errorf("expected required scopes %v, got %v", expectedScopes, apiErr.RequiredScopes)
+			}
+		}
+	})
+
+	t.Run("エラーケース: ステータスコード403でスコープ情報がない場合、RequiredScopesはnil", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error": "forbidden"}`))
+		}))
+		defer server.Close()
+
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("failed to make request: %v", err)
+		}
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+
+		err = checkResponseError(resp)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected APIError, got %T", err)
+		}
+		if !apiErr.IsForbidden() {
+			t.Error("expected IsForbidden to be true")
+		}
+		if apiErr.RequiredScopes != nil {
+			t.Errorf("expected nil required scopes, got %v", apiErr.RequiredScopes)
+		}
+	})
+
+	t.Run("エラーケース: ステータスコード400の場合、IsForbiddenはfalse", func(t *testing.T) {
+		t.Parallel()
+
+		apiErr := &APIError{StatusCode: http.StatusBadRequest}
+		if apiErr.IsForbidden() {
+			t.Error("expected IsForbidden to be false")
+		}
+	})
+
+	t.Run("エラーケース: レスポンスのリクエストID、ヘッダー、ボディを保持する", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/plain")
+			w.Header().Set(RequestIDHeader, "req-123")
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"message": "slow down"}`))
+		}))
+		defer server.Close()
+
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("failed to make request: %v", err)
+		}
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+
+		err = checkResponseError(resp)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected APIError, got %T", err)
+		}
+		if apiErr.RequestID != "req-123" {
+			t.Errorf("expected request ID req-123, got %q", apiErr.RequestID)
+		}
+		if got := apiErr.Header.Get("X-RateLimit-Remaining"); got != "0" {
+			t.Errorf("expected X-RateLimit-Remaining 0, got %q", got)
+		}
+		if apiErr.RawMessage != `{"message": "slow down"}` {
+			t.Errorf("expected the raw body, got %q", apiErr.RawMessage)
+		}
+	})
+
+	t.Run("エラーケース: ボディがJSONでない場合もリクエストIDを保持する", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(RequestIDHeader, "req-456")
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = w.Write([]byte(`<html>Bad Gateway</html>`))
+		}))
+		defer server.Close()
+
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("failed to make request: %v", err)
+		}
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+
+		err = checkResponseError(resp)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected APIError, got %T", err)
+		}
+		if apiErr.RequestID != "req-456" {
+			t.Errorf("expected request ID req-456, got %q", apiErr.RequestID)
+		}
+		if apiErr.RawMessage != `<html>Bad Gateway</html>` {
+			t.Errorf("expected the raw body, got %q", apiErr.RawMessage)
+		}
+	})
+}
+
+func TestErrNotFound(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error": "not_found", "error_description": "Resource not found"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	baseURL, err := url.Parse(server.URL + "/")
+	if err != nil {
+		t.Fatalf("failed to parse base URL: %v", err)
+	}
+
+	client := &Client{
+		httpClient: http.DefaultClient,
+		config: &Config{
+			BaseURL: baseURL,
+		},
+	}
+	setTestToken(client, "test-access-token")
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{
+			name: "異常系: GetCategoryの404はErrNotFoundとして判定できる",
+			call: func() error {
+				_, err := client.GetCategory(context.Background(), 123)
+				return err
+			},
+		},
+		{
+			name: "異常系: GetPersonalAccountBalancesの404はErrNotFoundとして判定できる",
+			call: func() error {
+				_, err := client.GetPersonalAccountBalances(context.Background(), "account_key_123")
+				return err
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.call()
+			if !errors.Is(err, ErrNotFound) {
+				t.Fatalf("expected ErrNotFound, got %v", err)
+			}
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected *APIError, got %T", err)
+			}
+			if !apiErr.IsNotFound() {
+				t.Errorf("expected IsNotFound to be true for status %d", apiErr.StatusCode)
+			}
+			if apiErr.ErrorType != "not_found" {
+				t.Errorf("expected ErrorType not_found, got %s", apiErr.ErrorType)
+			}
+		})
+	}
+
+	t.Run("正常系: 404以外のAPIErrorはErrNotFoundとして判定されない", func(t *testing.T) {
+		t.Parallel()
+
+		err := error(&APIError{StatusCode: http.StatusForbidden})
+		if errors.Is(err, ErrNotFound) {
+			t.Error("expected 403 not to match ErrNotFound")
+		}
+	})
+}
+
+func TestHTTPStatusForClient(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{name: "正常系: nilは200を返す", err: nil, expected: http.StatusOK},
+		{name: "正常系: 401はそのまま返す", err: &APIError{StatusCode: http.StatusUnauthorized}, expected: http.StatusUnauthorized},
+		{name: "正常系: 404はそのまま返す", err: &APIError{StatusCode: http.StatusNotFound}, expected: http.StatusNotFound},
+		{name: "正常系: 429はそのまま返す", err: &APIError{StatusCode: http.StatusTooManyRequests}, expected: http.StatusTooManyRequests},
+		{name: "正常系: 405は502を返す", err: &APIError{StatusCode: http.StatusMethodNotAllowed}, expected: http.StatusBadGateway},
+		{name: "正常系: 500は502を返す", err: &APIError{StatusCode: http.StatusInternalServerError}, expected: http.StatusBadGateway},
+		{name: "正常系: 503は502を返す", err: &APIError{StatusCode: http.StatusServiceUnavailable}, expected: http.StatusBadGateway},
+		{name: "正常系: ラップされたAPIErrorのステータスを返す", err: fmt.Errorf("failed: %w", &APIError{StatusCode: http.StatusForbidden}), expected: http.StatusForbidden},
+		{name: "正常系: ErrNoTokenは401を返す", err: ErrNoToken, expected: http.StatusUnauthorized},
+		{name: "正常系: タイムアウトは504を返す", err: fmt.Errorf("page 2: %w", context.DeadlineExceeded), expected: http.StatusGatewayTimeout},
+		{name: "正常系: ErrTruncatedResponseは502を返す", err: fmt.Errorf("%w from /link/profile.json", ErrTruncatedResponse), expected: http.StatusBadGateway},
+		{name: "正常系: ネットワークエラーは502を返す", err: &url.Error{Op: "Get", URL: "https://example.com", Err: errors.New("connection refused")}, expected: http.StatusBadGateway},
+		{name: "正常系: その他のエラーは500を返す", err: errors.New("account ID is required"), expected: http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := HTTPStatusForClient(tt.err); got != tt.expected {
+				t.Errorf("expected %d, got %d", tt.expected, got)
+			}
+		})
+	}
 }
@@ -3,6 +3,7 @@ package moneytree
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,6 +12,7 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -19,27 +21,66 @@ import (
 // RequestOption configures a request.
 type RequestOption func(*http.Request)
 
-// RetryConfig configures retry behavior for rate-limited requests.
+// RetryConfig configures retry behavior for rate-limited requests and transient server errors.
+//
+// A request answered with 429 Too Many Requests is retried whatever its method, as the API did not
+// process it. A GET or HEAD request answered with 502 Bad Gateway, 503 Service Unavailable or
+// 504 Gateway Timeout is retried as well; other methods are not, as the API may have processed them.
+// The delay before each retry grows exponentially from BaseDelay with jitter, unless the response has
+// a Retry-After header, which is honored instead. If the context has a deadline that would pass before
+// the next attempt, the last error is returned right away instead of waiting.
 type RetryConfig struct {
-	// MaxRetries is the maximum number of retry attempts for rate-limited requests.
+	// MaxRetries is the maximum number of retry attempts.
 	// Default is 3.
 	MaxRetries int
 	// BaseDelay is the base delay in milliseconds for exponential backoff.
 	// Default is 3000ms as recommended by Moneytree LINK API documentation.
 	BaseDelay time.Duration
-	// Enabled enables automatic retry for rate-limited requests (HTTP 429).
+	// Enabled enables automatic retry for rate-limited requests (HTTP 429)
+	// and transient server errors (HTTP 502, 503 and 504).
 	// Default is true.
 	Enabled bool
 }
 
 // Client is the main client for interacting with the Moneytree LINK API.
+// Create it with NewClient; the methods of a Client that was not, such as &Client{},
+// return ErrClientNotConfigured. A Client must not be copied after first use.
 type Client struct {
 	httpClient  *http.Client
 	config      *Config
 	retryConfig RetryConfig
 	token       *OauthToken
-	tokenMutex  *sync.Mutex
+	tokenMutex  sync.Mutex
 	getTokenErr error
+	// ownsHTTPClient reports whether httpClient was created by NewClient,
+	// in which case Close may release its connections.
+	ownsHTTPClient bool
+	// clock provides the current time and waiting. A nil clock means the real clock.
+	clock clock
+	// debugWriter receives request and response dumps when set by WithDebug.
+	debugWriter io.Writer
+	// maxBodyLogBytes limits the body bytes dumped by WithDebug. Zero means the default, negative means no limit.
+	maxBodyLogBytes int
+	// prettyPrintRequestBody indents JSON request bodies in debug dumps when set by WithPrettyPrintRequestBody.
+	prettyPrintRequestBody bool
+	// flights coalesces identical concurrent GET requests when set by WithSingleFlight.
+	flights *flightGroup
+	// beforeCall and afterCall are the hooks set by WithBeforeCall and WithAfterCall.
+	beforeCall func(req *http.Request)
+	afterCall  func(req *http.Request, resp *http.Response, err error)
+	// paginationTimeout bounds each multi-page fetch when set by WithPaginationTimeout.
+	paginationTimeout time.Duration
+	// applicationID is sent in the ApplicationIDHeader of every request when set by WithApplicationID.
+	applicationID string
+	// maxResponseTime bounds each attempt of a request, including its response body, when set by WithMaxResponseTime.
+	maxResponseTime time.Duration
+	// tokenRefreshSkew is how long before its expiry the token is refreshed when set by WithTokenRefreshSkew.
+	// A nil tokenRefreshSkew means defaultTokenRefreshSkew.
+	tokenRefreshSkew *time.Duration
+	// stats holds the request counters returned by Stats.
+	stats clientStats
+	// optionErr holds the errors of invalid NewClientOptions, which NewClient returns.
+	optionErr error
 }
 
 // newHTTPClient creates a new HTTP client with appropriate timeouts and connection pool settings.
@@ -68,11 +109,14 @@ func newHTTPClient() *http.Client {
 	}
 }
 
+// ApplicationIDHeader is the request header in which WithApplicationID sends the application identifier.
+const ApplicationIDHeader = "X-Application-ID"
+
 // NewClientOption configures options for creating a new Client.
 type NewClientOption func(*Client)
 
-// WithRetryConfig configures retry behavior for rate-limited requests.
-// This option allows you to customize retry settings according to your needs.
+// WithRetryConfig configures retry behavior for rate-limited requests and transient server errors.
+// See RetryConfig for the responses that are retried. This option allows you to customize retry settings according to your needs.
 //
 // Example:
 //
@@ -91,6 +135,257 @@ func WithRetryConfig(config RetryConfig) NewClientOption {
 	}
 }
 
+// WithSkipClientValidation disables the local validation of option values
+// (date formats, sort_key, sort_by, locale, etc.) and sends them to the API as is.
+// Use this only if you accept that invalid values are reported by the API instead of this package.
+//
+// Example:
+//
+//	client, err := moneytree.NewClient("jp-api-staging",
+//		moneytree.WithSkipClientValidation(),
+//	)
+func WithSkipClientValidation() NewClientOption {
+	return func(c *Client) {
+		c.config.SkipClientValidation = true
+	}
+}
+
+// WithDateLayouts sets the time layouts that Client.ParseTime tries in order (see Config.DateLayouts).
+// The layouts replace the defaults, so include time.RFC3339 and time.DateOnly if they should still be accepted.
+//
+// Example:
+//
+//	client, err := moneytree.NewClient("jp-api-staging",
+//		moneytree.WithDateLayouts(time.RFC3339, time.DateOnly, "2006/01/02"),
+//	)
+func WithDateLayouts(layouts ...string) NewClientOption {
+	return func(c *Client) {
+		c.config.DateLayouts = layouts
+	}
+}
+
+// WithRejectFutureSince makes methods reject a since option that is a date after today
+// (see Config.RejectFutureSince), instead of sending a request that silently returns no records.
+//
+// Example:
+//
+//	client, err := moneytree.NewClient("jp-api-staging",
+//		moneytree.WithRejectFutureSince(),
+//	)
+func WithRejectFutureSince() NewClientOption {
+	return func(c *Client) {
+		c.config.RejectFutureSince = true
+	}
+}
+
+// WithLogRedactor sets the function that rewrites header and JSON body values in the dumps written by
+// WithDebug (see Config.LogRedactor).
+//
+// Example:
+//
+//	client, err := moneytree.NewClient("jp-api-staging",
+//		moneytree.WithDebug(os.Stderr),
+//		moneytree.WithLogRedactor(func(key, value string) string {
+//			if key == "institution_account_number" {
+//				return "[REDACTED]"
+//			}
+//			return value
+//		}),
+//	)
+func WithLogRedactor(redactor func(key, value string) string) NewClientOption {
+	return func(c *Client) {
+		c.config.LogRedactor = redactor
+	}
+}
+
+// WithSubtypeClassifier sets the function that classifies the account subtypes unknown to
+// DefaultSubtypeClassifier (see Config.SubtypeClassifier).
+//
+// Example:
+//
+//	client, err := moneytree.NewClient("jp-api-staging",
+//		moneytree.WithSubtypeClassifier(func(subtype string) moneytree.AccountCategory {
+//			if strings.HasSuffix(subtype, "_loan") {
+//				return moneytree.AccountCategoryLoan
+//			}
+//			return moneytree.AccountCategoryUnknown
+//		}),
+//	)
+func WithSubtypeClassifier(classifier func(subtype string) AccountCategory) NewClientOption {
+	return func(c *Client) {
+		c.config.SubtypeClassifier = classifier
+	}
+}
+
+// WithResponseValidator sets the function that checks the body of every successful response
+// (see Config.ResponseValidator).
+//
+// Example:
+//
+//	client, err := moneytree.NewClient("jp-api-staging",
+//		moneytree.WithResponseValidator(func(path string, body []byte) error {
+//			if path != "/link/accounts.json" {
+//				return nil
+//			}
+//			var res moneytree.PersonalAccounts
+//			if err := json.Unmarshal(body, &res); err != nil {
+//				return err
+//			}
+//			for _, account := range res.Accounts {
+//				if account.Currency == nil {
+//					return fmt.Errorf("account %s has no currency", account.AccountKey)
+//				}
+//			}
+//			return nil
+//		}),
+//	)
+func WithResponseValidator(validator func(path string, body []byte) error) NewClientOption {
+	return func(c *Client) {
+		c.config.ResponseValidator = validator
+	}
+}
+
+// WithInsecureSkipVerify disables the verification of the server's TLS certificate chain and host name.
+// This is UNSAFE: it makes the connection vulnerable to man-in-the-middle attacks and must never be
+// enabled against production. It is intended only for staging environments that use self-signed certificates.
+//
+// The option applies to the HTTP client that NewClient creates; it has no effect on a Client
+// whose HTTP client is managed by the caller.
+//
+// Example:
+//
+//	client, err := moneytree.NewClient("jp-api-staging",
+//		moneytree.WithInsecureSkipVerify(true),
+//	)
+func WithInsecureSkipVerify(skip bool) NewClientOption {
+	return func(c *Client) {
+		transport := c.ownedTransport()
+		if transport == nil {
+			return
+		}
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+		// nolint:gosec // G402: Skipping verification is an explicit opt-in for staging environments
+		transport.TLSClientConfig.InsecureSkipVerify = skip
+	}
+}
+
+// ownedTransport returns the transport of the HTTP client that NewClient created,
+// or nil if the HTTP client is managed by the caller.
+func (c *Client) ownedTransport() *http.Transport {
+	if !c.ownsHTTPClient || c.httpClient == nil {
+		return nil
+	}
+	transport, ok := c.httpClient.Transport.(*http.Transport)
+	if !ok {
+		return nil
+	}
+	return transport
+}
+
+// WithDialTimeout sets the maximum time to establish a TCP connection to the API. The default is 5 seconds;
+// zero means no limit, and a negative duration makes NewClient return an error.
+// Like WithInsecureSkipVerify, it applies to the HTTP client that NewClient creates, and it is bounded
+// by the 30-second timeout of that client, which covers the whole request.
+//
+// Example:
+//
+//	client, err := moneytree.NewClient("jp-api-staging",
+//		moneytree.WithDialTimeout(2*time.Second),
+//	)
+func WithDialTimeout(d time.Duration) NewClientOption {
+	return func(c *Client) {
+		if d < 0 {
+			c.optionErr = errors.Join(c.optionErr, fmt.Errorf("dial timeout must not be negative, got %v", d))
+			return
+		}
+		if transport := c.ownedTransport(); transport != nil {
+			transport.DialContext = (&net.Dialer{Timeout: d}).DialContext
+		}
+	}
+}
+
+// WithTLSHandshakeTimeout sets the maximum time to complete the TLS handshake once connected.
+// The default is 10 seconds; zero means no limit, and a negative duration makes NewClient return an error.
+// It applies to the HTTP client that NewClient creates.
+//
+// Example:
+//
+//	client, err := moneytree.NewClient("jp-api-staging",
+//		moneytree.WithTLSHandshakeTimeout(5*time.Second),
+//	)
+func WithTLSHandshakeTimeout(d time.Duration) NewClientOption {
+	return func(c *Client) {
+		if d < 0 {
+			c.optionErr = errors.Join(c.optionErr, fmt.Errorf("TLS handshake timeout must not be negative, got %v", d))
+			return
+		}
+		if transport := c.ownedTransport(); transport != nil {
+			transport.TLSHandshakeTimeout = d
+		}
+	}
+}
+
+// WithResponseHeaderTimeout sets the maximum time to wait for the headers of a response once the
+// request has been sent, so that a server that accepts connections but does not answer is given up on
+// early. It does not cover reading the body; see WithMaxResponseTime for that. The default is 10 seconds;
+// zero means no limit, and a negative duration makes NewClient return an error.
+// It applies to the HTTP client that NewClient creates.
+//
+// Example:
+//
+//	client, err := moneytree.NewClient("jp-api-staging",
+//		moneytree.WithResponseHeaderTimeout(5*time.Second),
+//	)
+func WithResponseHeaderTimeout(d time.Duration) NewClientOption {
+	return func(c *Client) {
+		if d < 0 {
+			c.optionErr = errors.Join(c.optionErr, fmt.Errorf("response header timeout must not be negative, got %v", d))
+			return
+		}
+		if transport := c.ownedTransport(); transport != nil {
+			transport.ResponseHeaderTimeout = d
+		}
+	}
+}
+
+// WithProxyURL routes every request through the HTTP, HTTPS or SOCKS5 proxy at proxyURL,
+// e.g. "http://proxy.example.com:8080". Credentials for the proxy can be given in the URL's user info.
+// It takes precedence over the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables,
+// which the HTTP client created by NewClient otherwise follows.
+// NewClient returns an error if proxyURL is not an absolute URL with one of these schemes.
+//
+// Like WithInsecureSkipVerify, the option applies to the HTTP client that NewClient creates.
+//
+// Example:
+//
+//	client, err := moneytree.NewClient("jp-api-staging",
+//		moneytree.WithProxyURL("http://proxy.example.com:8080"),
+//	)
+func WithProxyURL(proxyURL string) NewClientOption {
+	return func(c *Client) {
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			c.optionErr = errors.Join(c.optionErr, fmt.Errorf("failed to parse proxy URL: %w", err))
+			return
+		}
+		switch u.Scheme {
+		case "http", "https", "socks5":
+		default:
+			c.optionErr = errors.Join(c.optionErr, fmt.Errorf("proxy URL must have an http, https or socks5 scheme, got %q", u.Scheme))
+			return
+		}
+		if u.Host == "" {
+			c.optionErr = errors.Join(c.optionErr, fmt.Errorf("proxy URL must have a host"))
+			return
+		}
+		if transport := c.ownedTransport(); transport != nil {
+			transport.Proxy = http.ProxyURL(u)
+		}
+	}
+}
+
 func NewClient(accountName string, opts ...NewClientOption) (*Client, error) {
 	if accountName == "" {
 		return nil, fmt.Errorf("account name is required")
@@ -109,16 +404,187 @@ func NewClient(accountName string, opts ...NewClientOption) (*Client, error) {
 			BaseDelay:  3000 * time.Millisecond,
 			Enabled:    true,
 		},
-		tokenMutex: &sync.Mutex{},
+		ownsHTTPClient: true,
 	}
 
 	for _, opt := range opts {
 		opt(c)
 	}
+	if c.optionErr != nil {
+		return nil, c.optionErr
+	}
 
 	return c, nil
 }
 
+// Close releases the idle connections held by the HTTP client that NewClient created for c.
+// Call Close when a Client is no longer needed, e.g. when creating a Client per tenant
+// in a long-running service, so that its pooled connections do not linger.
+//
+// Close is safe to call multiple times. The Client remains usable after Close;
+// subsequent requests simply open new connections. If the Client was not created
+// by NewClient, Close does nothing, and the owner of the HTTP client is responsible for it.
+//
+// Example:
+//
+//	client, err := moneytree.NewClient("jp-api-staging")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer client.Close()
+func (c *Client) Close() {
+	if c.ownsHTTPClient && c.httpClient != nil {
+		c.httpClient.CloseIdleConnections()
+	}
+}
+
+// WithApplicationID sends id in the X-Application-ID header (see ApplicationIDHeader) of every request,
+// so that Moneytree can attribute the traffic to your application independently of the User-Agent.
+// NewClient returns an error if id is empty.
+//
+// Example:
+//
+//	client, err := moneytree.NewClient("jp-api-staging",
+//		moneytree.WithApplicationID("my-budget-app"),
+//	)
+func WithApplicationID(id string) NewClientOption {
+	return func(c *Client) {
+		if id == "" {
+			c.optionErr = errors.Join(c.optionErr, fmt.Errorf("application ID is required"))
+			return
+		}
+		c.applicationID = id
+	}
+}
+
+// WithMaxResponseTime bounds the time each attempt of a request may take, from sending the request
+// to reading the last byte of the response body. A server that keeps a response alive by sending its
+// body a few bytes at a time is cut off when the time runs out, and the call returns an error that
+// matches ErrMaxResponseTime with errors.Is. Retries of rate-limited requests get a fresh budget each.
+//
+// Zero or a negative value, the default, disables the limit; the 30-second timeout of the HTTP client
+// created by NewClient still applies.
+//
+// Example:
+//
+//	client, err := moneytree.NewClient("jp-api-staging",
+//		moneytree.WithMaxResponseTime(10*time.Second),
+//	)
+func WithMaxResponseTime(d time.Duration) NewClientOption {
+	return func(c *Client) {
+		c.maxResponseTime = d
+	}
+}
+
+// WithInitialToken seeds the Client with an access token obtained elsewhere, so that it can be used
+// right away without calling SetToken. The token is used until expiresAt, with the same 1-minute buffer
+// as Valid; as no refresh token is seeded, the calls made after that return ErrNoToken until SetToken is called.
+//
+// If expiresAt is zero, the token is treated as non-expiring: it is used until the API rejects it with
+// 401 Unauthorized, and the calls made after that return ErrNoToken. NewClient returns an error if token is empty.
+//
+// Example:
+//
+//	client, err := moneytree.NewClient("jp-api-staging",
+//		moneytree.WithInitialToken(accessToken, expiresAt),
+//	)
+func WithInitialToken(token string, expiresAt time.Time) NewClientOption {
+	return func(c *Client) {
+		if token == "" {
+			c.optionErr = errors.Join(c.optionErr, fmt.Errorf("initial token is required"))
+			return
+		}
+		seeded := &OauthToken{AccessToken: &token}
+		if expiresAt.IsZero() {
+			seeded.nonExpiring = true
+		} else {
+			createdAt := int(expiresAt.Unix())
+			expiresIn := 0
+			seeded.CreatedAt = &createdAt
+			seeded.ExpiresIn = &expiresIn
+		}
+		c.SetToken(seeded)
+	}
+}
+
+// WithDefaultPerPage sets the per_page value sent when no per_page option is given (see Config.DefaultPerPage).
+// NewClient returns an error if perPage is not between 1 and 500.
+//
+// Example:
+//
+//	client, err := moneytree.NewClient("jp-api-staging",
+//		moneytree.WithDefaultPerPage(100),
+//	)
+func WithDefaultPerPage(perPage int) NewClientOption {
+	return func(c *Client) {
+		if perPage < 1 || perPage > maxPerPage {
+			c.optionErr = errors.Join(c.optionErr, fmt.Errorf("per_page must be between 1 and %d, got: %d", maxPerPage, perPage))
+			return
+		}
+		c.config.DefaultPerPage = perPage
+	}
+}
+
+// WithRequestTimeout sets the time limit of every call made by the Client when the context of the call
+// has no earlier deadline (see Config.RequestTimeout). NewClient returns an error if d is negative.
+//
+// Example:
+//
+//	client, err := moneytree.NewClient("jp-api-staging",
+//		moneytree.WithRequestTimeout(20*time.Second),
+//	)
+func WithRequestTimeout(d time.Duration) NewClientOption {
+	return func(c *Client) {
+		if d < 0 {
+			c.optionErr = errors.Join(c.optionErr, fmt.Errorf("request timeout must not be negative, got %v", d))
+			return
+		}
+		c.config.RequestTimeout = d
+	}
+}
+
+// WithTokenRefreshSkew sets how long before its expiry the token is refreshed with its refresh token,
+// so that a request is not sent with a token that expires on the way. The default is 1 minute; increase it
+// when the clocks of your servers may drift from the API's. NewClient returns an error if d is negative.
+//
+// Example:
+//
+//	client, err := moneytree.NewClient("jp-api-staging",
+//		moneytree.WithTokenRefreshSkew(5*time.Minute),
+//	)
+func WithTokenRefreshSkew(d time.Duration) NewClientOption {
+	return func(c *Client) {
+		if d < 0 {
+			c.optionErr = errors.Join(c.optionErr, fmt.Errorf("token refresh skew must not be negative, got %v", d))
+			return
+		}
+		c.tokenRefreshSkew = &d
+	}
+}
+
+// maxResponseTimeContext returns a context for one attempt of a request, which expires after the
+// maximum response time if one is set, and its cancel function.
+func (c *Client) maxResponseTimeContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.maxResponseTime <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, c.maxResponseTime)
+}
+
+// cancelOnCloseBody is a response body that cancels the context of its attempt when it is closed,
+// so that the maximum response time also covers reading the body.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close closes the body and cancels the context of its attempt.
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
 // NewRequest creates an API request. A relative URL can be provided in urlStr,
 // in which case it is resolved relative to the BaseURL of the Client.
 // Relative URLs should always be specified without a preceding slash. If
@@ -128,6 +594,9 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body any
 	if ctx == nil {
 		return nil, errNonNilContext
 	}
+	if c.config == nil || c.config.BaseURL == nil {
+		return nil, ErrClientNotConfigured
+	}
 	if !strings.HasSuffix(c.config.BaseURL.Path, "/") {
 		return nil, fmt.Errorf("baseURL must have a trailing slash, but %q does not", c.config.BaseURL)
 	}
@@ -172,6 +641,9 @@ func (c *Client) NewFormRequest(ctx context.Context, urlStr string, body io.Read
 	if ctx == nil {
 		return nil, errNonNilContext
 	}
+	if c.config == nil || c.config.BaseURL == nil {
+		return nil, ErrClientNotConfigured
+	}
 	if !strings.HasSuffix(c.config.BaseURL.Path, "/") {
 		return nil, fmt.Errorf("baseURL must have a trailing slash, but %q does not", c.config.BaseURL)
 	}
@@ -204,6 +676,57 @@ func isRateLimitError(err error) bool {
 	return false
 }
 
+// isRetriableError reports whether a request with method that failed with err may be sent again:
+// rate-limited requests always may, and requests answered with a transient server error
+// (HTTP 502, 503 or 504) may if they are idempotent.
+func isRetriableError(method string, err error) bool {
+	if isRateLimitError(err) {
+		return true
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotentMethod(method)
+	default:
+		return false
+	}
+}
+
+// retryAfter returns the delay requested by the Retry-After header of resp, given either in seconds
+// or as an HTTP date, and whether the header was present and valid.
+func retryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	value := strings.TrimSpace(resp.Header.Get("Retry-After"))
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	return max(date.Sub(now), 0), true
+}
+
+// exceedsDeadline reports whether waiting for delay would run past the deadline of ctx.
+func exceedsDeadline(ctx context.Context, now time.Time, delay time.Duration) bool {
+	deadline, ok := ctx.Deadline()
+	return ok && now.Add(delay).After(deadline)
+}
+
+// isIdempotentMethod reports whether a request with method can be sent again without side effects,
+// so that it may be retried after a network failure.
+func isIdempotentMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
 // calculateBackoffDelay calculates the exponential backoff delay with jitter.
 // Formula: wait_interval = base * 2^n +/- jitter
 // Reference: https://docs.link.getmoneytree.com/docs/faq-rate-limiting
@@ -260,26 +783,69 @@ func (c *Client) setAuthorizationHeader(req *http.Request) {
 	}
 }
 
-func (c *Client) Do(ctx context.Context, req *http.Request, v any) (*http.Response, error) {
+func (c *Client) Do(ctx context.Context, req *http.Request, v any) (resp *http.Response, err error) {
 	if ctx == nil {
 		return nil, errNonNilContext
 	}
+	if c.config == nil {
+		return nil, ErrClientNotConfigured
+	}
+
+	if c.config.RequestTimeout > 0 {
+		// context.WithTimeout keeps the deadline of the parent if it is earlier.
+		var cancel, cancelReq context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.config.RequestTimeout)
+		defer cancel()
+		var reqCtx context.Context
+		reqCtx, cancelReq = context.WithTimeout(req.Context(), c.config.RequestTimeout)
+		defer cancelReq()
+		req = req.WithContext(reqCtx)
+	}
+
+	if c.applicationID != "" {
+		req.Header.Set(ApplicationIDHeader, c.applicationID)
+	}
+
+	defer func() {
+		if err != nil {
+			c.stats.errors.Add(1)
+		}
+	}()
+
+	callOpts := callOptionsFromContext(ctx)
 
-	// Initialize tokenMutex if it's nil (for test clients created directly)
-	if c.tokenMutex == nil {
-		c.tokenMutex = &sync.Mutex{}
+	// The hooks wrap the whole call, so they fire once however many attempts are made.
+	if c.beforeCall != nil && !callOpts.noHooks {
+		c.beforeCall(req)
+	}
+	if c.afterCall != nil && !callOpts.noHooks {
+		defer func() {
+			c.afterCall(req, resp, err)
+		}()
 	}
 
-	// Check if this is an OAuth token endpoint that doesn't require authentication
-	requiresAuth := !c.isOAuthTokenEndpoint(req.URL)
+	// Check if this is an OAuth token endpoint that doesn't require authentication,
+	// or if the caller explicitly opted out of authentication for this call
+	requiresAuth := !c.isOAuthTokenEndpoint(req.URL) && !callOpts.noAuth
+
+	// An access token given for this call replaces the client's token, which is then neither refreshed nor used
+	usesClientToken := requiresAuth && callOpts.accessToken == ""
 
 	// Refresh token if authentication is required
-	if requiresAuth {
+	if usesClientToken {
+		// refreshToken already describes its errors, so that ErrNoToken reads the same for every method.
 		if err := c.refreshToken(ctx); err != nil {
-			return nil, fmt.Errorf("refresh token: %w", err)
+			return nil, err
 		}
 		// Set Authorization header if token is available
 		c.setAuthorizationHeader(req)
+	} else if requiresAuth {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", callOpts.accessToken))
+	}
+
+	// Checked after the token so that a missing token is reported as ErrNoToken.
+	if c.httpClient == nil {
+		return nil, ErrClientNotConfigured
 	}
 
 	// Read the request body once and store it for potential retries
@@ -295,10 +861,20 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v any) (*http.Respon
 		req.Body = io.NopCloser(bytes.NewReader(bodyBytes))
 	}
 
+	if c.flights != nil && req.Method == http.MethodGet && bodyBytes == nil {
+		return c.sendShared(ctx, req, usesClientToken, v)
+	}
+	return c.send(ctx, req, bodyBytes, usesClientToken, v)
+}
+
+// send sends an authenticated request, retrying rate-limited responses, and decodes the response body into v.
+func (c *Client) send(ctx context.Context, req *http.Request, bodyBytes []byte, usesClientToken bool, v any) (*http.Response, error) {
+	retryConfig := callOptionsFromContext(ctx).retryConfigFor(c.retryConfig)
+
 	var lastErr error
 	var lastResp *http.Response
 
-	for attempt := 0; attempt <= c.retryConfig.MaxRetries; attempt++ {
+	for attempt := 0; attempt <= retryConfig.MaxRetries; attempt++ {
 		// Clone the request for retries (body can only be read once)
 		var currentReq *http.Request
 		if attempt == 0 {
@@ -309,13 +885,43 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v any) (*http.Respon
 			if err != nil {
 				return lastResp, fmt.Errorf("failed to clone request for retry: %w", err)
 			}
-			// Re-set Authorization header for retries if authentication is required
-			if requiresAuth {
+			// Re-set Authorization header for retries, as the client's token may have been refreshed meanwhile.
+			// The header of a per-call access token is kept by cloneRequest.
+			if usesClientToken {
 				c.setAuthorizationHeader(currentReq)
 			}
 		}
 
+		// The budget of WithMaxResponseTime is released when the response body is closed.
+		parentCtx := currentReq.Context()
+		attemptCtx, cancelAttempt := c.maxResponseTimeContext(parentCtx)
+		currentReq = currentReq.WithContext(attemptCtx)
+		// Closing the body cancels the attempt, so only a deadline means that the limit was exceeded.
+		exceededMaxResponseTime := func() bool {
+			return errors.Is(attemptCtx.Err(), context.DeadlineExceeded) && parentCtx.Err() == nil
+		}
+
+		c.dumpRequest(currentReq, bodyBytes)
+		start := c.getClock().Now()
 		resp, err := c.httpClient.Do(currentReq)
+		c.logRequest(currentReq, attempt+1, start, resp, err)
+		c.stats.requests.Add(1)
+		if attempt > 0 {
+			c.stats.retries.Add(1)
+		}
+		if err == nil {
+			c.stats.recordResponse(resp.StatusCode)
+			resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancelAttempt}
+			c.dumpResponse(resp)
+		} else {
+			cancelAttempt()
+			if exceededMaxResponseTime() {
+				if resp != nil && resp.Body != nil {
+					_ = resp.Body.Close()
+				}
+				return resp, fmt.Errorf("%w for %s: %w", ErrMaxResponseTime, req.URL.Path, err)
+			}
+		}
 		if err != nil {
 			// If we got an error, and the context has been canceled,
 			// the context's error is probably more useful.
@@ -351,25 +957,38 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v any) (*http.Respon
 			lastErr = err
 			lastResp = resp
 
-			// If it's a rate limit error and retry is enabled, attempt retry
-			if isRateLimitError(err) && c.retryConfig.Enabled && attempt < c.retryConfig.MaxRetries {
+			// If it's a rate limit or transient server error and retry is enabled, attempt retry
+			if isRetriableError(req.Method, err) && retryConfig.Enabled && attempt < retryConfig.MaxRetries {
 				// Close the response body before retrying
 				_ = resp.Body.Close()
 
-				// Calculate backoff delay
-				delay := calculateBackoffDelay(c.retryConfig.BaseDelay, attempt)
+				// The delay requested by the server takes precedence over the backoff delay
+				now := c.getClock().Now()
+				delay, ok := retryAfter(resp, now)
+				if !ok {
+					delay = calculateBackoffDelay(retryConfig.BaseDelay, attempt)
+				}
+
+				// Waiting past the deadline would only turn the error into context.DeadlineExceeded
+				if exceedsDeadline(ctx, now, delay) {
+					return resp, err
+				}
 
 				// Wait before retrying
-				select {
-				case <-ctx.Done():
-					return resp, ctx.Err()
-				case <-time.After(delay):
-					// Continue to retry
-					continue
+				if err := c.getClock().Sleep(ctx, delay); err != nil {
+					return resp, err
+				}
+				continue
+			}
+
+			if usesClientToken {
+				var apiErr *APIError
+				if errors.As(err, &apiErr) && apiErr.IsUnauthorized() {
+					c.dropRejectedToken(strings.TrimPrefix(currentReq.Header.Get("Authorization"), "Bearer "))
 				}
 			}
 
-			// Not a rate limit error, or retries exhausted, or retry disabled
+			// Not a retriable error, or retries exhausted, or retry disabled
 			defer func() {
 				if resp != nil && resp.Body != nil {
 					_ = resp.Body.Close()
@@ -378,27 +997,36 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v any) (*http.Respon
 			return resp, err
 		}
 
-		// Success - process the response
-		defer func() {
-			if resp != nil && resp.Body != nil {
-				_ = resp.Body.Close()
+		// Success - process the response.
+		// The body is read completely before decoding, so that a connection dropped mid-body
+		// is told apart from a malformed document: both surface as io.ErrUnexpectedEOF from the decoder.
+		body, readErr := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if readErr != nil {
+			if exceededMaxResponseTime() {
+				return resp, fmt.Errorf("%w for %s: %w", ErrMaxResponseTime, req.URL.Path, readErr)
 			}
-		}()
-
-		switch v := v.(type) {
-		case nil:
-		case io.Writer:
-			_, err = io.Copy(v, resp.Body)
-		default:
-			decErr := json.NewDecoder(resp.Body).Decode(v)
-			if decErr == io.EOF {
-				decErr = nil // ignore EOF errors caused by empty response body
+			err := fmt.Errorf("%w from %s: %w", ErrTruncatedResponse, req.URL.Path, readErr)
+			if isIdempotentMethod(req.Method) && retryConfig.Enabled && attempt < retryConfig.MaxRetries {
+				lastErr = err
+				lastResp = resp
+				if err := c.getClock().Sleep(ctx, calculateBackoffDelay(retryConfig.BaseDelay, attempt)); err != nil {
+					return resp, err
+				}
+				continue
 			}
-			if decErr != nil {
-				err = decErr
+			return resp, err
+		}
+
+		if err := decodeResponseBody(resp, bytes.NewReader(body), v, req.URL.Path); err != nil {
+			return resp, err
+		}
+		if c.config != nil && c.config.ResponseValidator != nil {
+			if err := c.config.ResponseValidator(req.URL.Path, body); err != nil {
+				return resp, fmt.Errorf("failed to validate response from %s: %w", req.URL.Path, err)
 			}
 		}
-		return resp, err
+		return resp, nil
 	}
 
 	// All retries exhausted
@@ -408,6 +1036,31 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v any) (*http.Respon
 	return lastResp, lastErr
 }
 
+// decodeResponseBody copies body into v if v is an io.Writer, or decodes it into v as JSON otherwise.
+// A nil v discards the body. path is the request path reported with ErrEmptyResponseBody.
+func decodeResponseBody(resp *http.Response, body io.Reader, v any, path string) error {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case io.Writer:
+		_, err := io.Copy(v, body)
+		return err
+	default:
+		// The Content-Type is deliberately not checked: some proxies and gateways
+		// serve JSON bodies as text/plain, and rejecting them would be a false failure.
+		err := json.NewDecoder(body).Decode(v)
+		if errors.Is(err, io.EOF) {
+			// 204 No Content legitimately has no body; anything else means the
+			// caller expected a JSON document that the server did not send.
+			if resp.StatusCode == http.StatusNoContent {
+				return nil
+			}
+			return fmt.Errorf("%w from %s", ErrEmptyResponseBody, path)
+		}
+		return err
+	}
+}
+
 // isOAuthTokenEndpoint checks if the URL is an OAuth token endpoint that doesn't require authentication.
 func (c *Client) isOAuthTokenEndpoint(u *url.URL) bool {
 	if u == nil {
@@ -442,6 +1095,22 @@ func sanitizeURL(uri *url.URL) *url.URL {
 	return uri
 }
 
+// skipClientValidation reports whether the local validation of option values is disabled.
+func (c *Client) skipClientValidation() bool {
+	return c.config != nil && c.config.SkipClientValidation
+}
+
+// escapePathSegment escapes segment, such as an account key, for use as a single segment of a request path.
+// Slashes and other reserved characters are percent-encoded by url.PathEscape, and the dot segments
+// "." and ".." are encoded too, as they would otherwise be resolved against the BaseURL and route the
+// request to another endpoint.
+func escapePathSegment(segment string) string {
+	if segment == "." || segment == ".." {
+		return strings.Repeat("%2E", len(segment))
+	}
+	return url.PathEscape(segment)
+}
+
 // validateDateFormat validates that the date string is in the format "2006-01-02" (YYYY-MM-DD).
 func validateDateFormat(date string) error {
 	if _, err := time.Parse("2006-01-02", date); err != nil {
@@ -449,3 +1118,80 @@ func validateDateFormat(date string) error {
 	}
 	return nil
 }
+
+// validatePagination validates the page and per_page options against the ranges accepted by the API
+// and returns all problems found, joined with errors.Join.
+func validatePagination(opts *paginationOptions) error {
+	var errs []error
+	if opts.Page != nil && (*opts.Page < 1 || *opts.Page > maxPage) {
+		errs = append(errs, fmt.Errorf("page must be between 1 and %d, got: %d", maxPage, *opts.Page))
+	}
+	if opts.PerPage != nil && (*opts.PerPage < 1 || *opts.PerPage > maxPerPage) {
+		errs = append(errs, fmt.Errorf("per_page must be between 1 and %d, got: %d", maxPerPage, *opts.PerPage))
+	}
+	return errors.Join(errs...)
+}
+
+// validateListOptions validates the options shared by the transaction list endpoints
+// and returns all problems found, joined with errors.Join, so that they can be fixed at once.
+func validateListOptions(pagination *paginationOptions, sortKey, sortBy, since *string) error {
+	var errs []error
+	if since != nil {
+		if err := validateDateFormat(*since); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := validateSortKey(sortKey, SupportedTransactionSortKeys()); err != nil {
+		errs = append(errs, err)
+	}
+	if sortBy != nil && !isSupported(*sortBy, SupportedSortBy()) {
+		errs = append(errs, fmt.Errorf("sort_by must be 'asc' or 'desc', got: %s", *sortBy))
+	}
+	if err := validatePagination(pagination); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// validateSinceNotInFuture returns an error if Config.RejectFutureSince is set and since is a date
+// after today according to the Client's clock. A nil or malformed since is left to the format validation.
+func (c *Client) validateSinceNotInFuture(since *string) error {
+	if since == nil || c.config == nil || !c.config.RejectFutureSince {
+		return nil
+	}
+	if _, err := time.Parse(time.DateOnly, *since); err != nil {
+		return nil
+	}
+	today := c.getClock().Now().Format(time.DateOnly)
+	// Dates in YYYY-MM-DD format sort chronologically as strings.
+	if *since > today {
+		return fmt.Errorf("since must not be in the future, got since: %s, today: %s", *since, today)
+	}
+	return nil
+}
+
+// validateDateWindow validates the start_date and end_date of a date window, both of which may be nil.
+// It returns an error if only one of them is set, a date is not in YYYY-MM-DD format,
+// or start_date is after end_date.
+func validateDateWindow(startDate, endDate *string) error {
+	if startDate == nil && endDate == nil {
+		return nil
+	}
+	if endDate == nil {
+		return fmt.Errorf("end_date is required when start_date is specified")
+	}
+	if startDate == nil {
+		return fmt.Errorf("start_date is required when end_date is specified")
+	}
+	if err := validateDateFormat(*startDate); err != nil {
+		return err
+	}
+	if err := validateDateFormat(*endDate); err != nil {
+		return err
+	}
+	// Dates in YYYY-MM-DD format sort chronologically as strings.
+	if *startDate > *endDate {
+		return fmt.Errorf("start_date must be on or before end_date, got start_date: %s, end_date: %s", *startDate, *endDate)
+	}
+	return nil
+}
@@ -3,13 +3,17 @@ package moneytree
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -375,7 +379,6 @@ func TestDo_RetryOnRateLimit(t *testing.T) {
 				BaseDelay:  10 * time.Millisecond, // Short delay for testing
 				Enabled:    true,
 			},
-			tokenMutex: &sync.Mutex{},
 		}
 
 		setTestToken(client, "test-access-token")
@@ -432,7 +435,6 @@ func TestDo_RetryOnRateLimit(t *testing.T) {
 				BaseDelay:  10 * time.Millisecond, // Short delay for testing
 				Enabled:    true,
 			},
-			tokenMutex: &sync.Mutex{},
 		}
 
 		setTestToken(client, "test-access-token")
@@ -496,7 +498,6 @@ func TestDo_RetryOnRateLimit(t *testing.T) {
 				BaseDelay:  10 * time.Millisecond,
 				Enabled:    false, // Retry disabled
 			},
-			tokenMutex: &sync.Mutex{},
 		}
 
 		setTestToken(client, "test-access-token")
@@ -559,7 +560,6 @@ func TestDo_RetryOnRateLimit(t *testing.T) {
 				BaseDelay:  10 * time.Millisecond,
 				Enabled:    true,
 			},
-			tokenMutex: &sync.Mutex{},
 		}
 
 		setTestToken(client, "test-access-token")
@@ -594,3 +594,1422 @@ func TestDo_RetryOnRateLimit(t *testing.T) {
 		}
 	})
 }
+
+func TestDo_RetryOnTransientError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		method           string
+		statuses         []int
+		retryAfter       string
+		deadline         time.Duration
+		expectedAttempts int
+		expectedStatus   int
+		expectedSleeps   []time.Duration
+	}{
+		{
+			name:             "success case: a GET answered with 503 is retried",
+			method:           http.MethodGet,
+			statuses:         []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK},
+			expectedAttempts: 3,
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name:             "error case: a POST answered with 504 is not retried",
+			method:           http.MethodPost,
+			statuses:         []int{http.StatusGatewayTimeout, http.StatusOK},
+			expectedAttempts: 1,
+			expectedStatus:   http.StatusGatewayTimeout,
+		},
+		{
+			name:             "error case: 500 is not retried",
+			method:           http.MethodGet,
+			statuses:         []int{http.StatusInternalServerError, http.StatusOK},
+			expectedAttempts: 1,
+			expectedStatus:   http.StatusInternalServerError,
+		},
+		{
+			name:             "success case: Retry-After in seconds is honored",
+			method:           http.MethodPost,
+			statuses:         []int{http.StatusTooManyRequests, http.StatusOK},
+			retryAfter:       "7",
+			expectedAttempts: 2,
+			expectedStatus:   http.StatusOK,
+			expectedSleeps:   []time.Duration{7 * time.Second},
+		},
+		{
+			name:             "error case: a retry that would pass the context deadline is not attempted",
+			method:           http.MethodGet,
+			statuses:         []int{http.StatusTooManyRequests, http.StatusOK},
+			retryAfter:       "60",
+			deadline:         time.Second,
+			expectedAttempts: 1,
+			expectedStatus:   http.StatusTooManyRequests,
+			expectedSleeps:   []time.Duration{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				status := tt.statuses[min(int(attempts.Add(1))-1, len(tt.statuses)-1)]
+				w.Header().Set("Content-Type", "application/json")
+				if status == http.StatusTooManyRequests && tt.retryAfter != "" {
+					w.Header().Set("Retry-After", tt.retryAfter)
+				}
+				w.WriteHeader(status)
+				_, _ = w.Write([]byte(`{"status": "ok"}`))
+			}))
+			defer server.Close()
+
+			clk := newFakeClock(time.Now())
+			client := &Client{
+				httpClient: http.DefaultClient,
+				config: &Config{
+					BaseURL: &url.URL{Scheme: "http", Host: "example.com", Path: "/"},
+				},
+				retryConfig: RetryConfig{
+					MaxRetries: 3,
+					BaseDelay:  10 * time.Millisecond,
+					Enabled:    true,
+				},
+				clock: clk,
+			}
+			setTestToken(client, "test-access-token")
+
+			ctx := context.Background()
+			if tt.deadline > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.deadline)
+				defer cancel()
+			}
+			req, err := http.NewRequestWithContext(ctx, tt.method, server.URL, strings.NewReader(`{}`))
+			if err != nil {
+				t.Fatalf("failed to create request: %v", err)
+			}
+
+			resp, err := client.Do(ctx, req, nil)
+			if tt.expectedStatus == http.StatusOK {
+				if err != nil {
+					t.Fatalf("expected nil, got %v", err)
+				}
+			} else {
+				var apiErr *APIError
+				if !errors.As(err, &apiErr) {
+					t.Fatalf("expected APIError, got %v", err)
+				}
+				if apiErr.StatusCode != tt.expectedStatus {
+					t.Errorf("expected status code %d, got %d", tt.expectedStatus, apiErr.StatusCode)
+				}
+			}
+			if resp.StatusCode != tt.expectedStatus {
+				t.Errorf("expected response status code %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+			if got := int(attempts.Load()); got != tt.expectedAttempts {
+				t.Errorf("expected %d attempts, got %d", tt.expectedAttempts, got)
+			}
+			if tt.expectedSleeps != nil && !slices.Equal(clk.Sleeps(), tt.expectedSleeps) {
+				t.Errorf("expected sleeps %v, got %v", tt.expectedSleeps, clk.Sleeps())
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name          string
+		header        string
+		expectedDelay time.Duration
+		expectedOK    bool
+	}{
+		{name: "success case: seconds", header: "120", expectedDelay: 2 * time.Minute, expectedOK: true},
+		{name: "success case: HTTP date", header: now.Add(30 * time.Second).Format(http.TimeFormat), expectedDelay: 30 * time.Second, expectedOK: true},
+		{name: "success case: HTTP date in the past", header: now.Add(-time.Minute).Format(http.TimeFormat), expectedDelay: 0, expectedOK: true},
+		{name: "error case: missing", header: "", expectedOK: false},
+		{name: "error case: negative", header: "-1", expectedOK: false},
+		{name: "error case: malformed", header: "soon", expectedOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &http.Response{Header: http.Header{}}
+			if tt.header != "" {
+				resp.Header.Set("Retry-After", tt.header)
+			}
+			delay, ok := retryAfter(resp, now)
+			if delay != tt.expectedDelay || ok != tt.expectedOK {
+				t.Errorf("expected (%v, %v), got (%v, %v)", tt.expectedDelay, tt.expectedOK, delay, ok)
+			}
+		})
+	}
+}
+
+func TestDo_EmptyResponseBody(t *testing.T) {
+	t.Parallel()
+
+	t.Run("error case: 200 with zero-length body returns ErrEmptyResponseBody with the path", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		setTestToken(client, "test-access-token")
+		_, err = client.GetProfile(context.Background())
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if !errors.Is(err, ErrEmptyResponseBody) {
+			t.Errorf("expected ErrEmptyResponseBody, got %v", err)
+		}
+		expectedMessage := "empty response body from /link/profile.json"
+		if err.Error() != expectedMessage {
+			t.Errorf("expected error message %q, got %q", expectedMessage, err.Error())
+		}
+	})
+
+	t.Run("success case: 204 with zero-length body is not an error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		setTestToken(client, "test-access-token")
+		req, err := client.NewRequest(context.Background(), http.MethodGet, "test/path", nil)
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+
+		var result map[string]string
+		if _, err := client.Do(context.Background(), req, &result); err != nil {
+			t.Errorf("expected nil, got %v", err)
+		}
+	})
+}
+
+func TestDo_NonJSONContentType(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: JSON body served as text/plain is decoded", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"key": "value"}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		setTestToken(client, "test-access-token")
+		req, err := client.NewRequest(context.Background(), http.MethodGet, "test/path", nil)
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+
+		var result map[string]string
+		if _, err := client.Do(context.Background(), req, &result); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if result["key"] != "value" {
+			t.Errorf("expected key to be value, got %v", result)
+		}
+	})
+}
+
+func TestWithSkipClientValidation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: NewClient enables SkipClientValidation", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewClient("jp-api-staging", WithSkipClientValidation())
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if !client.config.SkipClientValidation {
+			t.Error("expected SkipClientValidation to be true")
+		}
+	})
+
+	t.Run("success case: otherwise rejected date is sent to the API when validation is skipped", func(t *testing.T) {
+		t.Parallel()
+
+		invalidDate := "2023/01/01"
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if actualSince := r.URL.Query().Get("since"); actualSince != invalidDate {
+				t.Errorf("expected since parameter %s, got %s", invalidDate, actualSince)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"account_balances": []}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL:              baseURL,
+				SkipClientValidation: true,
+			},
+		}
+
+		setTestToken(client, "test-access-token")
+		if _, err := client.GetPersonalAccountBalances(context.Background(), "account_key_123", WithSinceForBalances(invalidDate)); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+	})
+
+	t.Run("error case: required arguments are still checked when validation is skipped", func(t *testing.T) {
+		t.Parallel()
+
+		baseURL, err := url.Parse("https://test.getmoneytree.com/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			config: &Config{
+				BaseURL:              baseURL,
+				SkipClientValidation: true,
+			},
+		}
+
+		setTestToken(client, "test-access-token")
+		if _, err := client.GetPersonalAccountBalances(context.Background(), ""); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
+
+// closeCountingTransport is an http.RoundTripper that counts CloseIdleConnections calls.
+type closeCountingTransport struct {
+	mu     sync.Mutex
+	closed int
+}
+
+func (t *closeCountingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func (t *closeCountingTransport) CloseIdleConnections() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.closed++
+}
+
+func TestClient_Close(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: Close can be called multiple times on a client created by NewClient", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewClient("jp-api-staging")
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+
+		transport := &closeCountingTransport{}
+		client.httpClient.Transport = transport
+
+		client.Close()
+		client.Close()
+
+		if transport.closed != 2 {
+			t.Errorf("expected CloseIdleConnections to be called 2 times, got %d", transport.closed)
+		}
+	})
+
+	t.Run("success case: Close does not touch a user-supplied HTTP client", func(t *testing.T) {
+		t.Parallel()
+
+		transport := &closeCountingTransport{}
+		client := &Client{
+			httpClient: &http.Client{Transport: transport},
+		}
+
+		client.Close()
+		client.Close()
+
+		if transport.closed != 0 {
+			t.Errorf("expected CloseIdleConnections not to be called, got %d calls", transport.closed)
+		}
+	})
+
+	t.Run("success case: Close is a no-op when the HTTP client is nil", func(t *testing.T) {
+		t.Parallel()
+
+		client := &Client{ownsHTTPClient: true}
+		client.Close()
+	})
+}
+
+func TestWithInsecureSkipVerify(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"email": "test@example.com"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	baseURL, err := url.Parse(server.URL + "/")
+	if err != nil {
+		t.Fatalf("failed to parse base URL: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		opts        []NewClientOption
+		expectError bool
+	}{
+		{name: "error case: self-signed certificate is rejected by default", opts: nil, expectError: true},
+		{name: "error case: self-signed certificate is rejected when disabled", opts: []NewClientOption{WithInsecureSkipVerify(false)}, expectError: true},
+		{name: "success case: self-signed certificate is accepted when enabled", opts: []NewClientOption{WithInsecureSkipVerify(true)}, expectError: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := NewClient("jp-api-staging", tt.opts...)
+			if err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+			defer client.Close()
+			client.config.BaseURL = baseURL
+			setTestToken(client, "test-access-token")
+
+			err = client.Ping(context.Background())
+			if tt.expectError && err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !tt.expectError && err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+		})
+	}
+}
+
+// writeTruncatedResponse writes a 200 response that announces a longer body than it sends,
+// then closes the connection, as when a proxy drops it mid-body.
+func writeTruncatedResponse(t *testing.T, w http.ResponseWriter) {
+	t.Helper()
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		t.Fatal("expected the response writer to support hijacking")
+	}
+	conn, buf, err := hijacker.Hijack()
+	if err != nil {
+		t.Fatalf("failed to hijack connection: %v", err)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	_, _ = buf.WriteString("HTTP/1.1 200 OK\r\nContent-Type: application/json\r\nContent-Length: 100\r\n\r\n")
+	_, _ = buf.WriteString(`{"email": "user@exa`)
+	_ = buf.Flush()
+}
+
+func TestDo_TruncatedResponse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("error case: a body cut off by the connection is reported as ErrTruncatedResponse", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			writeTruncatedResponse(t, w)
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+		setTestToken(client, "test-access-token")
+
+		_, err = client.GetProfile(context.Background())
+		if !errors.Is(err, ErrTruncatedResponse) {
+			t.Fatalf("expected ErrTruncatedResponse, got %v", err)
+		}
+		if !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Errorf("expected io.ErrUnexpectedEOF, got %v", err)
+		}
+	})
+
+	t.Run("success case: a GET is retried after a truncated body", func(t *testing.T) {
+		t.Parallel()
+
+		var attempts int
+		var mu sync.Mutex
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			attempts++
+			attempt := attempts
+			mu.Unlock()
+			if attempt == 1 {
+				writeTruncatedResponse(t, w)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"email": "user@example.com"}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		clk := newFakeClock(time.Now())
+		client, err := NewClient("jp-api-staging", withClockForTesting(clk))
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		client.config.BaseURL = baseURL
+		setTestToken(client, "test-access-token")
+
+		profile, err := client.GetProfile(context.Background())
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if profile.Email != "user@example.com" {
+			t.Errorf("expected email user@example.com, got %s", profile.Email)
+		}
+		if attempts != 2 {
+			t.Errorf("expected 2 attempts, got %d", attempts)
+		}
+		if len(clk.Sleeps()) != 1 {
+			t.Errorf("expected 1 backoff wait, got %d", len(clk.Sleeps()))
+		}
+	})
+
+	t.Run("error case: malformed JSON is not mistaken for a truncated body", func(t *testing.T) {
+		t.Parallel()
+
+		var attempts int
+		var mu sync.Mutex
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			attempts++
+			mu.Unlock()
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"email": "user@exa`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client, err := NewClient("jp-api-staging", withClockForTesting(newFakeClock(time.Now())))
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		client.config.BaseURL = baseURL
+		setTestToken(client, "test-access-token")
+
+		_, err = client.GetProfile(context.Background())
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if errors.Is(err, ErrTruncatedResponse) {
+			t.Errorf("expected a decode error, got %v", err)
+		}
+		if attempts != 1 {
+			t.Errorf("expected 1 attempt, got %d", attempts)
+		}
+	})
+
+	t.Run("error case: truncated body is detected with debug dumps enabled", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			writeTruncatedResponse(t, w)
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient:  http.DefaultClient,
+			config:      &Config{BaseURL: baseURL},
+			debugWriter: io.Discard,
+		}
+		setTestToken(client, "test-access-token")
+
+		if _, err := client.GetProfile(context.Background()); !errors.Is(err, ErrTruncatedResponse) {
+			t.Fatalf("expected ErrTruncatedResponse, got %v", err)
+		}
+	})
+}
+
+func TestWithApplicationID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		opts     []NewClientOption
+		expected string
+	}{
+		{name: "success case: the header is sent when configured", opts: []NewClientOption{WithApplicationID("my-budget-app")}, expected: "my-budget-app"},
+		{name: "success case: the header is absent by default", opts: nil, expected: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r.Header.Values(ApplicationIDHeader)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"email": "test@example.com"}`))
+			}))
+			defer server.Close()
+
+			baseURL, err := url.Parse(server.URL + "/")
+			if err != nil {
+				t.Fatalf("failed to parse base URL: %v", err)
+			}
+
+			client, err := NewClient("jp-api-staging", tt.opts...)
+			if err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+			client.config.BaseURL = baseURL
+			setTestToken(client, "test-access-token")
+
+			if err := client.Ping(context.Background()); err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+			if tt.expected == "" {
+				if len(got) != 0 {
+					t.Errorf("expected no %s header, got %v", ApplicationIDHeader, got)
+				}
+				return
+			}
+			if len(got) != 1 || got[0] != tt.expected {
+				t.Errorf("expected %s header %q, got %v", ApplicationIDHeader, tt.expected, got)
+			}
+		})
+	}
+
+	t.Run("error case: NewClient rejects an empty application ID", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewClient("jp-api-staging", WithApplicationID(""))
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if client != nil {
+			t.Errorf("expected nil client, got %v", client)
+		}
+	})
+}
+
+func TestEscapePathSegment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		accountKey string
+		expected   string
+	}{
+		{name: "success case: a slash and a space are escaped", accountKey: "key/with space", expected: "/link/accounts/key%2Fwith%20space/transactions.json"},
+		{name: "success case: a dot-dot segment is not resolved", accountKey: "..", expected: "/link/accounts/%2E%2E/transactions.json"},
+		{name: "success case: a dot segment is not resolved", accountKey: ".", expected: "/link/accounts/%2E/transactions.json"},
+		{name: "success case: dots within a key are kept", accountKey: "key.json", expected: "/link/accounts/key.json/transactions.json"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r.URL.EscapedPath()
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"transactions": []}`))
+			}))
+			defer server.Close()
+
+			baseURL, err := url.Parse(server.URL + "/")
+			if err != nil {
+				t.Fatalf("failed to parse base URL: %v", err)
+			}
+
+			client := &Client{
+				httpClient: http.DefaultClient,
+				config: &Config{
+					BaseURL: baseURL,
+				},
+			}
+			setTestToken(client, "test-access-token")
+
+			if _, err := client.GetPersonalAccountTransactions(context.Background(), tt.accountKey); err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("expected path %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestWithRejectFutureSince(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, time.March, 31, 23, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		reject      bool
+		since       string
+		expectError bool
+	}{
+		{name: "success case: a future since is sent by default", reject: false, since: "2023-04-01", expectError: false},
+		{name: "error case: a future since is rejected when enabled", reject: true, since: "2023-04-01", expectError: true},
+		{name: "success case: today is accepted when enabled", reject: true, since: "2023-03-31", expectError: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"transactions": []}`))
+			}))
+			defer server.Close()
+
+			baseURL, err := url.Parse(server.URL + "/")
+			if err != nil {
+				t.Fatalf("failed to parse base URL: %v", err)
+			}
+
+			client := &Client{
+				httpClient: http.DefaultClient,
+				config: &Config{
+					BaseURL:           baseURL,
+					RejectFutureSince: tt.reject,
+				},
+				clock: newFakeClock(now),
+			}
+			setTestToken(client, "test-access-token")
+
+			_, err = client.GetPersonalAccountTransactions(context.Background(), "account_key_123", WithSinceForTransactions(tt.since))
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), "since must not be in the future") {
+					t.Fatalf("expected future since error, got %v", err)
+				}
+				if got := requests.Load(); got != 0 {
+					t.Errorf("expected no request, got %d", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+		})
+	}
+
+	t.Run("success case: NewClient enables the check", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewClient("jp-api-staging", WithRejectFutureSince())
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if !client.config.RejectFutureSince {
+			t.Error("expected RejectFutureSince to be set")
+		}
+	})
+}
+
+func TestWithMaxResponseTime(t *testing.T) {
+	t.Parallel()
+
+	// newSlowServer returns a server that sends a profile body one byte per 100ms.
+	newSlowServer := func(t *testing.T) *httptest.Server {
+		t.Helper()
+
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			flusher, _ := w.(http.Flusher)
+			for _, b := range []byte(`{"email": "user@example.com"}`) {
+				if _, err := w.Write([]byte{b}); err != nil {
+					return
+				}
+				flusher.Flush()
+				select {
+				case <-r.Context().Done():
+					return
+				case <-time.After(100 * time.Millisecond):
+				}
+			}
+		}))
+	}
+
+	t.Run("error case: a body trickling past the limit is cut off", func(t *testing.T) {
+		t.Parallel()
+
+		server := newSlowServer(t)
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client, err := NewClient("jp-api-staging", WithMaxResponseTime(300*time.Millisecond))
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		defer client.Close()
+		client.config.BaseURL = baseURL
+		setTestToken(client, "test-access-token")
+
+		start := time.Now()
+		_, err = client.GetProfile(context.Background())
+		if !errors.Is(err, ErrMaxResponseTime) {
+			t.Fatalf("expected ErrMaxResponseTime, got %v", err)
+		}
+		if errors.Is(err, ErrTruncatedResponse) {
+			t.Errorf("expected the error not to be ErrTruncatedResponse, got %v", err)
+		}
+		// The whole body takes about 3 seconds to arrive.
+		if elapsed := time.Since(start); elapsed > 2*time.Second {
+			t.Errorf("expected the request to be cut off after about 300ms, took %v", elapsed)
+		}
+	})
+
+	t.Run("success case: a response within the limit is decoded", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"email": "user@example.com"}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+			maxResponseTime: 5 * time.Second,
+		}
+		setTestToken(client, "test-access-token")
+
+		profile, err := client.GetProfile(context.Background())
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if profile.Email != "user@example.com" {
+			t.Errorf("expected email user@example.com, got %s", profile.Email)
+		}
+	})
+
+	t.Run("error case: the caller's deadline is not reported as the limit", func(t *testing.T) {
+		t.Parallel()
+
+		server := newSlowServer(t)
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+			maxResponseTime: 5 * time.Second,
+		}
+		setTestToken(client, "test-access-token")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+		defer cancel()
+		_, err = client.GetProfile(ctx)
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if errors.Is(err, ErrMaxResponseTime) {
+			t.Errorf("expected the error not to be ErrMaxResponseTime, got %v", err)
+		}
+	})
+}
+
+func TestWithProxyURL(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: requests are sent through the proxy", func(t *testing.T) {
+		t.Parallel()
+
+		var proxied atomic.Value
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// A proxy receives the absolute URL of the target in the request line.
+			proxied.Store(r.URL.String())
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"email": "user@example.com"}`))
+		}))
+		defer proxy.Close()
+
+		client, err := NewClient("jp-api-staging", WithProxyURL(proxy.URL))
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		defer client.Close()
+		client.config.BaseURL = &url.URL{Scheme: "http", Host: "api.example.invalid", Path: "/"}
+		setTestToken(client, "test-access-token")
+
+		profile, err := client.GetProfile(context.Background())
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if profile.Email != "user@example.com" {
+			t.Errorf("expected email user@example.com, got %s", profile.Email)
+		}
+		if got, _ := proxied.Load().(string); got != "http://api.example.invalid/link/profile.json" {
+			t.Errorf("expected the proxy to receive http://api.example.invalid/link/profile.json, got %q", got)
+		}
+	})
+
+	tests := []struct {
+		name     string
+		proxyURL string
+	}{
+		{name: "error case: a malformed URL", proxyURL: "http://proxy.example.com:port"},
+		{name: "error case: an unsupported scheme", proxyURL: "ftp://proxy.example.com"},
+		{name: "error case: a URL without a scheme", proxyURL: "proxy.example.com:8080"},
+		{name: "error case: a URL without a host", proxyURL: "http:///proxy"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := NewClient("jp-api-staging", WithProxyURL(tt.proxyURL))
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if client != nil {
+				t.Errorf("expected nil client, got %v", client)
+			}
+		})
+	}
+}
+
+func TestWithResponseValidator(t *testing.T) {
+	t.Parallel()
+
+	// requireCurrency rejects a personal accounts response in which an account has no currency.
+	requireCurrency := func(path string, body []byte) error {
+		if path != "/link/accounts.json" {
+			return nil
+		}
+		var res PersonalAccounts
+		if err := json.Unmarshal(body, &res); err != nil {
+			return err
+		}
+		for _, account := range res.Accounts {
+			if account.Currency == nil {
+				return fmt.Errorf("account %s has no currency", account.AccountKey)
+			}
+		}
+		return nil
+	}
+
+	tests := []struct {
+		name        string
+		body        string
+		expectError bool
+	}{
+		{
+			name: "success case: a response satisfying the validator is returned",
+			body: `{"accounts": [{"account_key": "account_a", "currency": "JPY"}]}`,
+		},
+		{
+			name:        "error case: a response missing a required field is rejected",
+			body:        `{"accounts": [{"account_key": "account_a", "currency": "JPY"}, {"account_key": "account_b"}]}`,
+			expectError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			baseURL, err := url.Parse(server.URL + "/")
+			if err != nil {
+				t.Fatalf("failed to parse base URL: %v", err)
+			}
+
+			client, err := NewClient("jp-api-staging", WithResponseValidator(requireCurrency))
+			if err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+			defer client.Close()
+			client.config.BaseURL = baseURL
+			setTestToken(client, "test-access-token")
+
+			accounts, err := client.GetPersonalAccounts(context.Background())
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if !strings.Contains(err.Error(), "account account_b has no currency") {
+					t.Errorf("expected the validator's error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+			if len(accounts.Accounts) != 1 {
+				t.Errorf("expected 1 account, got %d", len(accounts.Accounts))
+			}
+		})
+	}
+}
+
+func TestWithResponseHeaderTimeout(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		delay       time.Duration
+		expectError bool
+	}{
+		{name: "error case: a server slow to send headers trips the timeout", delay: 500 * time.Millisecond, expectError: true},
+		{name: "success case: a fast server succeeds", delay: 0, expectError: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				select {
+				case <-r.Context().Done():
+					return
+				case <-time.After(tt.delay):
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"email": "user@example.com"}`))
+			}))
+			defer server.Close()
+
+			baseURL, err := url.Parse(server.URL + "/")
+			if err != nil {
+				t.Fatalf("failed to parse base URL: %v", err)
+			}
+
+			client, err := NewClient("jp-api-staging",
+				WithDialTimeout(time.Second),
+				WithTLSHandshakeTimeout(time.Second),
+				WithResponseHeaderTimeout(100*time.Millisecond),
+			)
+			if err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+			defer client.Close()
+			client.config.BaseURL = baseURL
+			setTestToken(client, "test-access-token")
+
+			_, err = client.GetProfile(context.Background())
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), "timeout awaiting response headers") {
+					t.Errorf("expected a response header timeout, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+		})
+	}
+
+	t.Run("error case: negative transport timeouts are rejected", func(t *testing.T) {
+		t.Parallel()
+
+		for name, opt := range map[string]NewClientOption{
+			"dial timeout":            WithDialTimeout(-time.Second),
+			"TLS handshake timeout":   WithTLSHandshakeTimeout(-time.Second),
+			"response header timeout": WithResponseHeaderTimeout(-time.Second),
+		} {
+			_, err := NewClient("jp-api-staging", opt)
+			if err == nil || !strings.Contains(err.Error(), name+" must not be negative") {
+				t.Errorf("%s: expected error, got %v", name, err)
+			}
+		}
+	})
+}
+
+func TestClient_NotConfigured(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		call func(c *Client) error
+	}{
+		{name: "GetProfile", call: func(c *Client) error { _, err := c.GetProfile(ctx); return err }},
+		{name: "AllAccounts", call: func(c *Client) error { _, err := c.AllAccounts(ctx); return err }},
+		{name: "RetrieveToken", call: func(c *Client) error {
+			_, err := c.RetrieveToken(ctx, &RetrieveTokenRequest{GrantType: StringPtr("authorization_code")})
+			return err
+		}},
+		{name: "RevokeToken", call: func(c *Client) error { return c.RevokeToken(ctx, &RevokeTokenRequest{Token: "token"}) }},
+		{name: "Do", call: func(c *Client) error {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://example.com/", nil)
+			if err != nil {
+				return err
+			}
+			_, err = c.Do(ctx, req, nil)
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run("error case: "+tt.name+" on a zero-value Client", func(t *testing.T) {
+			t.Parallel()
+
+			client := &Client{}
+			// Setting a token must not panic either.
+			setTestToken(client, "test-access-token")
+			if err := tt.call(client); !errors.Is(err, ErrClientNotConfigured) {
+				t.Errorf("expected ErrClientNotConfigured, got %v", err)
+			}
+		})
+	}
+}
+
+func TestWithInitialToken(t *testing.T) {
+	t.Parallel()
+
+	newServer := func(t *testing.T, status int, tokenRequests *atomic.Int32, authorization *atomic.Value) *httptest.Server {
+		t.Helper()
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/oauth/token" {
+				tokenRequests.Add(1)
+			}
+			authorization.Store(r.Header.Get("Authorization"))
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(status)
+			if status == http.StatusOK {
+				_, _ = w.Write([]byte(`{"email": "user@example.com"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"error": "invalid_token"}`))
+		}))
+	}
+
+	tests := []struct {
+		name      string
+		expiresAt time.Time
+	}{
+		{name: "success case: a token with an expiry is used without a refresh", expiresAt: time.Now().Add(time.Hour)},
+		{name: "success case: a token with a zero expiry is used without a refresh", expiresAt: time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var tokenRequests atomic.Int32
+			var authorization atomic.Value
+			server := newServer(t, http.StatusOK, &tokenRequests, &authorization)
+			defer server.Close()
+
+			baseURL, err := url.Parse(server.URL + "/")
+			if err != nil {
+				t.Fatalf("failed to parse base URL: %v", err)
+			}
+
+			client, err := NewClient("jp-api-staging", WithInitialToken("seeded-access-token", tt.expiresAt))
+			if err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+			defer client.Close()
+			client.config.BaseURL = baseURL
+
+			for i := 0; i < 2; i++ {
+				if _, err := client.GetProfile(context.Background()); err != nil {
+					t.Fatalf("call %d: expected nil, got %v", i+1, err)
+				}
+			}
+			if got := authorization.Load(); got != "Bearer seeded-access-token" {
+				t.Errorf("expected the seeded token to be sent, got %v", got)
+			}
+			if got := tokenRequests.Load(); got != 0 {
+				t.Errorf("expected no token request, got %d", got)
+			}
+		})
+	}
+
+	t.Run("error case: an expired token returns ErrNoToken", func(t *testing.T) {
+		t.Parallel()
+
+		baseURL, err := url.Parse("https://test.getmoneytree.com/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client, err := NewClient("jp-api-staging", WithInitialToken("seeded-access-token", time.Now().Add(-time.Minute)))
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		client.config.BaseURL = baseURL
+		if _, err := client.GetProfile(context.Background()); !errors.Is(err, ErrNoToken) {
+			t.Errorf("expected ErrNoToken, got %v", err)
+		}
+	})
+
+	t.Run("error case: a token with a zero expiry is dropped after a 401", func(t *testing.T) {
+		t.Parallel()
+
+		var tokenRequests atomic.Int32
+		var authorization atomic.Value
+		server := newServer(t, http.StatusUnauthorized, &tokenRequests, &authorization)
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client, err := NewClient("jp-api-staging", WithInitialToken("seeded-access-token", time.Time{}))
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		defer client.Close()
+		client.config.BaseURL = baseURL
+
+		_, err = client.GetProfile(context.Background())
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || !apiErr.IsUnauthorized() {
+			t.Fatalf("expected a 401 APIError, got %v", err)
+		}
+		if _, err := client.GetProfile(context.Background()); !errors.Is(err, ErrNoToken) {
+			t.Errorf("expected ErrNoToken, got %v", err)
+		}
+	})
+
+	t.Run("error case: an empty token", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := NewClient("jp-api-staging", WithInitialToken("", time.Time{})); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
+
+func TestWithDefaultPerPage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		opts            []GetPersonalAccountsOption
+		expectedPerPage string
+	}{
+		{name: "success case: the default is sent without a per_page option", expectedPerPage: "50"},
+		{name: "success case: a per_page option takes precedence", opts: []GetPersonalAccountsOption{WithPerPage(10)}, expectedPerPage: "10"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var perPage atomic.Value
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				perPage.Store(r.URL.Query().Get("per_page"))
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"accounts": []}`))
+			}))
+			defer server.Close()
+
+			baseURL, err := url.Parse(server.URL + "/")
+			if err != nil {
+				t.Fatalf("failed to parse base URL: %v", err)
+			}
+
+			client, err := NewClient("jp-api-staging", WithDefaultPerPage(50))
+			if err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+			defer client.Close()
+			client.config.BaseURL = baseURL
+			setTestToken(client, "test-access-token")
+
+			if _, err := client.GetPersonalAccounts(context.Background(), tt.opts...); err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+			if got := perPage.Load(); got != tt.expectedPerPage {
+				t.Errorf("expected per_page %s, got %v", tt.expectedPerPage, got)
+			}
+		})
+	}
+
+	t.Run("error case: an out-of-range default", func(t *testing.T) {
+		t.Parallel()
+
+		for _, perPage := range []int{0, 501} {
+			if _, err := NewClient("jp-api-staging", WithDefaultPerPage(perPage)); err == nil {
+				t.Errorf("%d: expected error, got nil", perPage)
+			}
+		}
+	})
+
+	t.Run("error case: an out-of-range per_page option is rejected before sending", func(t *testing.T) {
+		t.Parallel()
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: &url.URL{Scheme: "https", Host: "test.getmoneytree.com", Path: "/"},
+			},
+		}
+		setTestToken(client, "test-access-token")
+
+		_, err := client.GetPersonalAccounts(context.Background(), WithPerPage(100000))
+		if err == nil || !strings.Contains(err.Error(), "per_page must be between 1 and 500") {
+			t.Errorf("expected a per_page error, got %v", err)
+		}
+	})
+}
+
+func TestWithRequestTimeout(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		requestTimeout time.Duration
+		ctxTimeout     time.Duration
+	}{
+		{name: "error case: the request timeout bounds a call without a deadline", requestTimeout: 50 * time.Millisecond},
+		{name: "error case: an earlier deadline of the caller wins", requestTimeout: time.Hour, ctxTimeout: 50 * time.Millisecond},
+		{name: "error case: an earlier request timeout wins over the deadline of the caller", requestTimeout: 50 * time.Millisecond, ctxTimeout: time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				select {
+				case <-r.Context().Done():
+				case <-time.After(5 * time.Second):
+				}
+			}))
+			defer server.Close()
+
+			baseURL, err := url.Parse(server.URL + "/")
+			if err != nil {
+				t.Fatalf("failed to parse base URL: %v", err)
+			}
+
+			client, err := NewClient("jp-api-staging", WithRequestTimeout(tt.requestTimeout))
+			if err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+			defer client.Close()
+			client.config.BaseURL = baseURL
+			setTestToken(client, "test-access-token")
+
+			ctx := context.Background()
+			if tt.ctxTimeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.ctxTimeout)
+				defer cancel()
+			}
+
+			start := time.Now()
+			_, err = client.GetProfile(ctx)
+			if !errors.Is(err, context.DeadlineExceeded) {
+				t.Errorf("expected context.DeadlineExceeded, got %v", err)
+			}
+			if elapsed := time.Since(start); elapsed > 2*time.Second {
+				t.Errorf("expected the call to time out early, took %v", elapsed)
+			}
+		})
+	}
+
+	t.Run("error case: a negative timeout", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := NewClient("jp-api-staging", WithRequestTimeout(-time.Second)); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
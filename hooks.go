@@ -0,0 +1,113 @@
+package moneytree
+
+import (
+	"net/http"
+	"time"
+)
+
+// WithBeforeCall registers a function that is called once at the start of every API call,
+// before the token is refreshed and the request is sent. Use it together with WithAfterCall,
+// e.g. to start a timer for a business metric that should cover the whole call.
+//
+// The hook fires once per call, not per attempt: retries of rate-limited or truncated responses
+// do not fire it again. (WithDebug, by contrast, dumps every attempt.) Nor does the token refresh
+// made before a call when the token has expired: it is part of the call, while calling RetrieveToken
+// directly fires the hook. Methods that fetch several pages, such as AllAccounts, make one call per page.
+// The hook must not modify req and is called from the goroutine making the call, so it must be
+// safe for concurrent use.
+//
+// Example:
+//
+//	client, err := moneytree.NewClient("jp-api-staging",
+//		moneytree.WithBeforeCall(func(req *http.Request) {
+//			inFlight.Add(1)
+//		}),
+//		moneytree.WithAfterCall(func(req *http.Request, resp *http.Response, err error) {
+//			inFlight.Add(-1)
+//		}),
+//	)
+func WithBeforeCall(hook func(req *http.Request)) NewClientOption {
+	return func(c *Client) {
+		c.beforeCall = hook
+	}
+}
+
+// WithAfterCall registers a function that is called once at the end of every API call with its
+// final result: the response of the last attempt, which may be nil, and the error returned to the
+// caller. Like WithBeforeCall, it fires once per call however many retries were made, and not for
+// the token refresh made during the call.
+// The response body has already been consumed and closed when the hook is called.
+//
+// Example:
+//
+//	client, err := moneytree.NewClient("jp-api-staging",
+//		moneytree.WithAfterCall(func(req *http.Request, resp *http.Response, err error) {
+//			if err != nil {
+//				log.Printf("%s %s failed: %v", req.Method, req.URL.Path, err)
+//			}
+//		}),
+//	)
+func WithAfterCall(hook func(req *http.Request, resp *http.Response, err error)) NewClientOption {
+	return func(c *Client) {
+		c.afterCall = hook
+	}
+}
+
+// RequestLog describes one HTTP request sent by the Client, for Config.RequestLogger.
+type RequestLog struct {
+	// Method is the HTTP method of the request, e.g. http.MethodGet.
+	Method string
+	// URL is the full URL of the request, with the client_secret, refresh_token and access_token
+	// query parameters redacted. The Authorization header is never included.
+	URL string
+	// Attempt is the number of the attempt, starting at 1; it is greater than 1 for retries.
+	Attempt int
+	// StatusCode is the status code of the response, or 0 if no response was received.
+	StatusCode int
+	// Duration is the time from sending the request to receiving the response headers.
+	Duration time.Duration
+	// Err is the error of the round trip, e.g. a network failure, or nil if a response was received.
+	// Responses with an error status are reported with their StatusCode and a nil Err.
+	Err error
+}
+
+// WithRequestLogger sets the function that is called after every HTTP request sent by the Client
+// (see Config.RequestLogger), e.g. to trace slow or failing endpoints with log/slog.
+//
+// Unlike WithAfterCall, it fires once per attempt, so that each retry is reported.
+//
+// Example:
+//
+//	client, err := moneytree.NewClient("jp-api-staging",
+//		moneytree.WithRequestLogger(func(l moneytree.RequestLog) {
+//			slog.Info("moneytree request", "method", l.Method, "url", l.URL,
+//				"status", l.StatusCode, "duration", l.Duration, "error", l.Err)
+//		}),
+//	)
+func WithRequestLogger(logger func(RequestLog)) NewClientOption {
+	return func(c *Client) {
+		c.config.RequestLogger = logger
+	}
+}
+
+// logRequest reports an attempt of req that started at start to Config.RequestLogger, if it is set.
+func (c *Client) logRequest(req *http.Request, attempt int, start time.Time, resp *http.Response, err error) {
+	if c.config == nil || c.config.RequestLogger == nil {
+		return
+	}
+	l := RequestLog{
+		Method:   req.Method,
+		Attempt:  attempt,
+		Duration: c.getClock().Now().Sub(start),
+		Err:      err,
+	}
+	if req.URL != nil {
+		// sanitizeURL modifies the URL it is given.
+		u := *req.URL
+		l.URL = sanitizeURL(&u).String()
+	}
+	if resp != nil {
+		l.StatusCode = resp.StatusCode
+	}
+	c.config.RequestLogger(l)
+}
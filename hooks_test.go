@@ -0,0 +1,257 @@
+package moneytree
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWithBeforeCallAndAfterCall(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: hooks fire once for a call that was retried twice", func(t *testing.T) {
+		t.Parallel()
+
+		var mu sync.Mutex
+		attempts := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			attempts++
+			attempt := attempts
+			mu.Unlock()
+
+			w.Header().Set("Content-Type", "application/json")
+			if attempt <= 2 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				_, _ = w.Write([]byte(`{"error": "rate_limit_exceeded"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"email": "user@example.com"}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		var before, after int
+		var afterStatus int
+		var afterErr error
+		client, err := NewClient("jp-api-staging",
+			withClockForTesting(newFakeClock(time.Now())),
+			WithBeforeCall(func(req *http.Request) {
+				before++
+				if req.URL.Path != "/link/profile.json" {
+					t.Errorf("unexpected path %s", req.URL.Path)
+				}
+			}),
+			WithAfterCall(func(req *http.Request, resp *http.Response, err error) {
+				after++
+				if resp != nil {
+					afterStatus = resp.StatusCode
+				}
+				afterErr = err
+			}),
+		)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		client.config.BaseURL = baseURL
+		setTestToken(client, "test-access-token")
+
+		if _, err := client.GetProfile(context.Background()); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if attempts != 3 {
+			t.Errorf("expected 3 attempts, got %d", attempts)
+		}
+		if before != 1 || after != 1 {
+			t.Errorf("expected each hook to fire once, got before %d, after %d", before, after)
+		}
+		if afterStatus != http.StatusOK || afterErr != nil {
+			t.Errorf("expected the final result 200 with nil error, got %d, %v", afterStatus, afterErr)
+		}
+	})
+
+	t.Run("success case: hooks do not fire for the token refresh made during a call", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/oauth/token":
+				_, _ = w.Write([]byte(fmt.Sprintf(`{"access_token": "new-access-token", "created_at": %d, "expires_in": 3600}`, time.Now().Unix())))
+			default:
+				_, _ = w.Write([]byte(`{"email": "user@example.com"}`))
+			}
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		var mu sync.Mutex
+		var beforePaths, afterPaths []string
+		client, err := NewClient("jp-api-staging",
+			WithBeforeCall(func(req *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				beforePaths = append(beforePaths, req.URL.Path)
+			}),
+			WithAfterCall(func(req *http.Request, resp *http.Response, err error) {
+				mu.Lock()
+				defer mu.Unlock()
+				afterPaths = append(afterPaths, req.URL.Path)
+			}),
+		)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		client.config.BaseURL = baseURL
+		accessToken, refreshToken := "expired-access-token", "refresh-token"
+		createdAt, expiresIn := int(time.Now().Add(-2*time.Hour).Unix()), 3600
+		client.SetToken(&OauthToken{AccessToken: &accessToken, RefreshToken: &refreshToken, CreatedAt: &createdAt, ExpiresIn: &expiresIn})
+
+		if _, err := client.GetProfile(context.Background()); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if strings.Join(beforePaths, ",") != "/link/profile.json" || strings.Join(afterPaths, ",") != "/link/profile.json" {
+			t.Errorf("expected the hooks to fire for the profile call only, got before %v, after %v", beforePaths, afterPaths)
+		}
+	})
+
+	t.Run("error case: the after hook receives the error returned to the caller", func(t *testing.T) {
+		t.Parallel()
+
+		baseURL, err := url.Parse("https://test.getmoneytree.com/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		var afterErr error
+		client, err := NewClient("jp-api-staging",
+			WithAfterCall(func(req *http.Request, resp *http.Response, err error) {
+				afterErr = err
+			}),
+		)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		client.config.BaseURL = baseURL
+
+		_, err = client.GetProfile(context.Background())
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if afterErr != err {
+			t.Errorf("expected the after hook to receive %v, got %v", err, afterErr)
+		}
+	})
+}
+
+func TestWithRequestLogger(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: every attempt is logged without the token", func(t *testing.T) {
+		t.Parallel()
+
+		var mu sync.Mutex
+		attempts := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			attempts++
+			attempt := attempts
+			mu.Unlock()
+
+			w.Header().Set("Content-Type", "application/json")
+			if attempt == 1 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				_, _ = w.Write([]byte(`{"error": "rate_limit_exceeded"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"transactions": []}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		var logs []RequestLog
+		client, err := NewClient("jp-api-staging",
+			withClockForTesting(newFakeClock(time.Now())),
+			WithRequestLogger(func(l RequestLog) {
+				mu.Lock()
+				defer mu.Unlock()
+				logs = append(logs, l)
+			}),
+		)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		client.config.BaseURL = baseURL
+		setTestToken(client, "test-access-token")
+
+		if _, err := client.GetPersonalAccountTransactions(context.Background(), "account_key_123", WithPageForTransactions(2)); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if len(logs) != 2 {
+			t.Fatalf("expected 2 logs, got %d", len(logs))
+		}
+		expectedURL := server.URL + "/link/accounts/account_key_123/transactions.json?page=2"
+		for i, expectedStatus := range []int{http.StatusTooManyRequests, http.StatusOK} {
+			l := logs[i]
+			if l.Method != http.MethodGet || l.URL != expectedURL || l.Attempt != i+1 || l.StatusCode != expectedStatus || l.Err != nil {
+				t.Errorf("log %d: unexpected %+v", i, l)
+			}
+			if strings.Contains(fmt.Sprintf("%+v", l), "test-access-token") {
+				t.Errorf("log %d: expected the token to be absent, got %+v", i, l)
+			}
+		}
+	})
+
+	t.Run("error case: a network failure is logged with its error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+		server.Close()
+
+		var logged RequestLog
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL:       baseURL,
+				RequestLogger: func(l RequestLog) { logged = l },
+			},
+		}
+		setTestToken(client, "test-access-token")
+
+		if _, err := client.GetProfile(context.Background()); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if logged.Err == nil || logged.StatusCode != 0 {
+			t.Errorf("expected an error without a status code, got %+v", logged)
+		}
+	})
+}
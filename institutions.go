@@ -63,6 +63,26 @@ type Institutions struct {
 	Institutions []Institution `json:"institutions"`
 }
 
+// DisplayNames returns the DisplayName of every institution keyed by EntityKey, to resolve the
+// InstitutionEntityKey of accounts into names that can be shown to customers.
+// Institutions without a display name are omitted.
+//
+// Example:
+//
+//	names := response.DisplayNames()
+//	for _, account := range accounts.Accounts {
+//		fmt.Printf("%s: %s\n", names[account.InstitutionEntityKey], account.AccountKey)
+//	}
+func (i *Institutions) DisplayNames() map[string]string {
+	names := make(map[string]string, len(i.Institutions))
+	for _, institution := range i.Institutions {
+		if institution.DisplayName != nil {
+			names[institution.EntityKey] = *institution.DisplayName
+		}
+	}
+	return names
+}
+
 // GetInstitutionsOption configures options for the GetInstitutions API call.
 type GetInstitutionsOption func(*getInstitutionsOptions)
 
@@ -95,20 +115,20 @@ func WithSince(since string) GetInstitutionsOption {
 //
 // Example:
 //
-//	client := moneytree.NewClient("jp-api-staging")
-//	response, err := client.GetInstitutions(ctx, systemAccessToken)
+//	ctx := moneytree.WithCallOptions(ctx, moneytree.WithAccessToken(systemAccessToken))
+//	response, err := client.GetInstitutions(ctx)
 //	if err != nil {
 //		log.Fatal(err)
 //	}
 //	for _, inst := range response.Institutions {
-//		if inst.Status == "available" {
-//			fmt.Printf("Available: %s (%s)\n", inst.Name, inst.EntityKey)
+//		if inst.Status != nil && *inst.Status == "active" && inst.DisplayName != nil {
+//			fmt.Printf("Active: %s (%s)\n", *inst.DisplayName, inst.EntityKey)
 //		}
 //	}
 //
 // Example with since parameter:
 //
-//	response, err := client.GetInstitutions(ctx, systemAccessToken, moneytree.WithSince("2023-01-01"))
+//	response, err := client.GetInstitutions(ctx, moneytree.WithSince("2023-01-01"))
 //	if err != nil {
 //		log.Fatal(err)
 //	}
@@ -120,8 +140,14 @@ func (c *Client) GetInstitutions(ctx context.Context, opts ...GetInstitutionsOpt
 		opt(options)
 	}
 
-	if options.Since != nil {
-		if err := validateDateFormat(*options.Since); err != nil {
+	if !c.skipClientValidation() {
+		if options.Since != nil {
+			if err := validateDateFormat(*options.Since); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := c.validateSinceNotInFuture(options.Since); err != nil {
 			return nil, err
 		}
 	}
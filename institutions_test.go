@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -18,18 +19,18 @@ func TestGetInstitutions(t *testing.T) {
 	t.Run("success case: institutions list is retrieved correctly", func(t *testing.T) {
 		t.Parallel()
 
-		displayName1 := stringPtr("Test Bank 1")
-		displayName2 := stringPtr("Test Bank 2")
-		displayName3 := stringPtr("Test Bank 3")
-		displayNameReading1 := stringPtr("テストバンク1")
-		displayNameReading2 := stringPtr("テストバンク2")
-		displayNameReading3 := stringPtr("テストバンク3")
-		status1 := stringPtr("active")
-		status2 := stringPtr("inactive")
-		statusReason1 := stringPtr("maintenance")
-		statusReason2 := stringPtr("legacy")
-		loginURL1 := stringPtr("https://example.com/login")
-		guidanceURL1 := stringPtr("https://example.com/guidance")
+		displayName1 := StringPtr("Test Bank 1")
+		displayName2 := StringPtr("Test Bank 2")
+		displayName3 := StringPtr("Test Bank 3")
+		displayNameReading1 := StringPtr("テストバンク1")
+		displayNameReading2 := StringPtr("テストバンク2")
+		displayNameReading3 := StringPtr("テストバンク3")
+		status1 := StringPtr("active")
+		status2 := StringPtr("inactive")
+		statusReason1 := StringPtr("maintenance")
+		statusReason2 := StringPtr("legacy")
+		loginURL1 := StringPtr("https://example.com/login")
+		guidanceURL1 := StringPtr("https://example.com/guidance")
 
 		expectedResponse := Institutions{
 			Institutions: []Institution{
@@ -55,7 +56,7 @@ func TestGetInstitutions(t *testing.T) {
 					StatusReason:             statusReason1,
 					LoginURL:                 nil,
 					GuidanceURL:              nil,
-					BillingGroup:             stringPtr("2"),
+					BillingGroup:             StringPtr("2"),
 					Tags:                     []string{"bank"},
 					DefaultAuthorizationType: 1,
 				},
@@ -152,8 +153,8 @@ func TestGetInstitutions(t *testing.T) {
 		t.Parallel()
 
 		sinceTime := "2023-01-01"
-		displayName := stringPtr("Test Bank 1")
-		status := stringPtr("active")
+		displayName := StringPtr("Test Bank 1")
+		status := StringPtr("active")
 
 		expectedResponse := Institutions{
 			Institutions: []Institution{
@@ -428,3 +429,24 @@ func TestWithSince_InvalidDateFormat(t *testing.T) {
 		}
 	})
 }
+
+func TestInstitutions_DisplayNames(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: display names are keyed by entity key", func(t *testing.T) {
+		t.Parallel()
+
+		institutions := &Institutions{
+			Institutions: []Institution{
+				{EntityKey: "test_bank_1", DisplayName: StringPtr("Test Bank 1")},
+				{EntityKey: "test_bank_2"},
+				{EntityKey: "test_card_1", DisplayName: StringPtr("Test Card 1")},
+			},
+		}
+
+		expected := map[string]string{"test_bank_1": "Test Bank 1", "test_card_1": "Test Card 1"}
+		if got := institutions.DisplayNames(); !reflect.DeepEqual(got, expected) {
+			t.Errorf("expected %v, got %v", expected, got)
+		}
+	})
+}
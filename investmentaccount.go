@@ -22,7 +22,8 @@ type InvestmentAccount struct {
 	// AccountSubtype describes the specific type of account.
 	// Possible values: "brokerage", "brokerage_cash", "pension_cash", "defined_contribution_pension",
 	// "term_life", "whole_life", etc.
-	AccountSubtype string `json:"account_subtype"`
+	// Values unknown to this package are kept as is; see AccountSubtype.IsKnown.
+	AccountSubtype AccountSubtype `json:"account_subtype"`
 	// AccountType describes the type of account.
 	// Deprecated: Use AccountSubtype instead, as it provides more detailed information.
 	AccountType string `json:"account_type"`
@@ -67,7 +68,7 @@ type InvestmentAccount struct {
 	// "auth.creds.invalid", "auth.creds.locked.temporary", "auth.creds.locked.permanent",
 	// "error.permanent", "error.temporary", "error.session", "error.network",
 	// "error.service.unavailable", "error.unsupported", "unknown".
-	AggregationStatus string `json:"aggregation_status"`
+	AggregationStatus AggregationStatus `json:"aggregation_status"`
 	// LastAggregatedAt is the last time data was acquired for this account.
 	// Format: ISO 8601 date-time.
 	LastAggregatedAt string `json:"last_aggregated_at"`
@@ -164,6 +165,41 @@ func (c *Client) GetInvestmentAccounts(ctx context.Context, opts ...GetInvestmen
 	return &res, nil
 }
 
+// GetInvestmentAccount retrieves a single investment account by its account key.
+// This endpoint requires the investment_accounts_read OAuth scope.
+//
+// If the account does not exist, the returned error is an *APIError with StatusCode 404,
+// which also matches ErrNotFound with errors.Is.
+//
+// Example:
+//
+//	account, err := client.GetInvestmentAccount(ctx, "account_key_123")
+//	if errors.Is(err, moneytree.ErrNotFound) {
+//		// The account does not exist or was deleted.
+//	}
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Account: %s, Subtype: %s, Balance: %v\n", account.AccountKey, account.AccountSubtype, account.CurrentBalance)
+func (c *Client) GetInvestmentAccount(ctx context.Context, accountKey string) (*InvestmentAccount, error) {
+	if accountKey == "" {
+		return nil, fmt.Errorf("account key is required")
+	}
+
+	urlPath := fmt.Sprintf("link/investments/accounts/%s.json", escapePathSegment(accountKey))
+
+	httpReq, err := c.NewRequest(ctx, http.MethodGet, urlPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var res InvestmentAccount
+	if _, err = c.Do(ctx, httpReq, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
 // InvestmentPosition represents a position record for an investment account returned by the Moneytree LINK API.
 // Unlike transaction details, position details represent what assets the customer currently holds at a point in time.
 // Positions change over time as market values fluctuate, so this API returns the most recently updated position details
@@ -281,7 +317,7 @@ func (c *Client) GetInvestmentPositions(ctx context.Context, accountID string, o
 		opt(options)
 	}
 
-	urlPath := fmt.Sprintf("link/investments/accounts/%s/positions.json", url.PathEscape(accountID))
+	urlPath := fmt.Sprintf("link/investments/accounts/%s/positions.json", escapePathSegment(accountID))
 	queryParams := url.Values{}
 	if options.Page != nil {
 		queryParams.Set("page", fmt.Sprintf("%d", *options.Page))
@@ -338,7 +374,7 @@ func WithPerPageForInvestmentTransactions(perPage int) GetInvestmentAccountTrans
 // Using sort_key may affect response time, so it is recommended to use it only when necessary.
 // If "date" is specified as the sort key, the database sorts by the transaction date
 // (which is the actual transaction date, not the date Moneytree obtained it).
-// The default value is "id".
+// The default value is "id". The possible values are listed by SupportedTransactionSortKeys.
 func WithSortKeyForInvestmentTransactions(sortKey string) GetInvestmentAccountTransactionsOption {
 	return func(opts *getTransactionsOptions) {
 		opts.SortKey = &sortKey
@@ -363,6 +399,17 @@ func WithSinceForInvestmentTransactions(since string) GetInvestmentAccountTransa
 	}
 }
 
+// ValidateInvestmentAccountTransactionsOptions validates opts as GetInvestmentAccountTransactions does,
+// without sending a request. Like ValidatePersonalAccountTransactionsOptions, it reports every
+// invalid option at once in an error joined with errors.Join.
+func ValidateInvestmentAccountTransactionsOptions(opts ...GetInvestmentAccountTransactionsOption) error {
+	options := &getTransactionsOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return options.validate()
+}
+
 // GetInvestmentAccountTransactions retrieves the transaction records for a specific investment account.
 // This endpoint requires the investment_transactions_read OAuth scope.
 //
@@ -407,21 +454,21 @@ func (c *Client) GetInvestmentAccountTransactions(ctx context.Context, accountID
 		opt(options)
 	}
 
-	if options.Since != nil {
-		if err := validateDateFormat(*options.Since); err != nil {
+	if !c.skipClientValidation() {
+		if err := options.validate(); err != nil {
 			return nil, err
 		}
-	}
 
-	if options.SortBy != nil {
-		if *options.SortBy != "asc" && *options.SortBy != "desc" {
-			return nil, fmt.Errorf("sort_by must be 'asc' or 'desc', got: %s", *options.SortBy)
+		if err := c.validateSinceNotInFuture(options.Since); err != nil {
+			return nil, err
 		}
 	}
 
-	urlPath := fmt.Sprintf("link/investments/accounts/%s/transactions.json", url.PathEscape(accountID))
+	urlPath := fmt.Sprintf("link/investments/accounts/%s/transactions.json", escapePathSegment(accountID))
 	queryParams := url.Values{}
-	applyPaginationParams(queryParams, &options.paginationOptions)
+	if err := c.applyPagination(queryParams, &options.paginationOptions); err != nil {
+		return nil, err
+	}
 	if options.SortKey != nil {
 		queryParams.Set("sort_key", *options.SortKey)
 	}
@@ -446,3 +493,107 @@ func (c *Client) GetInvestmentAccountTransactions(ctx context.Context, accountID
 	}
 	return &res, nil
 }
+
+// UpdateInvestmentAccountTransactionRequest represents a request to update an investment account transaction.
+// The specification is the same as updating a personal account transaction.
+// This type is an alias for UpdatePersonalAccountTransactionRequest for clarity and consistency.
+type UpdateInvestmentAccountTransactionRequest = UpdatePersonalAccountTransactionRequest
+
+// UpdateInvestmentAccountTransaction updates an investment account transaction.
+// This endpoint requires the transactions_write OAuth scope.
+//
+// This API allows guest users to add memos (transaction content) to transaction details
+// and edit category data automatically registered by Moneytree.
+// The specification is the same as UpdatePersonalAccountTransaction; only the API path differs.
+//
+// Example:
+//
+//	descriptionGuest := "新しいメモ"
+//	categoryID := int64(123)
+//	request := &moneytree.UpdateInvestmentAccountTransactionRequest{
+//		DescriptionGuest: &descriptionGuest,
+//		CategoryID:       &categoryID,
+//	}
+//	transaction, err := client.UpdateInvestmentAccountTransaction(ctx, "account_key_123", 1337, request)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Updated transaction: ID=%d, Description=%s\n", transaction.ID, *transaction.DescriptionGuest)
+func (c *Client) UpdateInvestmentAccountTransaction(ctx context.Context, accountID string, transactionID int64, req *UpdateInvestmentAccountTransactionRequest) (*InvestmentAccountTransaction, error) {
+	if accountID == "" {
+		return nil, fmt.Errorf("account ID is required")
+	}
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	if req.DescriptionGuest != nil && len(*req.DescriptionGuest) > 255 {
+		return nil, fmt.Errorf("description_guest must be 255 characters or less, got %d characters", len(*req.DescriptionGuest))
+	}
+
+	urlPath := fmt.Sprintf("link/investments/accounts/%s/transactions/%d.json", escapePathSegment(accountID), transactionID)
+
+	httpReq, err := c.NewRequest(ctx, http.MethodPut, urlPath, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var res InvestmentAccountTransaction
+	if _, err = c.Do(ctx, httpReq, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetAllInvestmentAccountTransactions retrieves the transactions of all investment accounts.
+// This endpoint requires the investment_accounts_read and investment_transactions_read OAuth scopes.
+//
+// This helper lists all investment accounts, fetches every page of each account's transactions,
+// and merges them into a single slice grouped by account in the order returned by GetInvestmentAccounts.
+// The AccountID of each transaction identifies its account.
+// Up to WithConcurrency accounts are fetched at the same time. If any request fails,
+// the remaining requests are canceled and the error, which names the account key, is returned,
+// unless WithErrorPolicy specifies ErrorPolicyCollect, in which case the transactions of the
+// accounts that could be fetched are returned along with the errors.
+// A failure to list the accounts is always returned alone.
+//
+// Example:
+//
+//	transactions, err := client.GetAllInvestmentAccountTransactions(ctx, moneytree.WithConcurrency(2))
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, transaction := range transactions {
+//		fmt.Printf("Account: %d, Date: %s, Amount: %v\n", transaction.AccountID, transaction.Date, transaction.Amount)
+//	}
+func (c *Client) GetAllInvestmentAccountTransactions(ctx context.Context, opts ...AggregateOption) ([]InvestmentAccountTransaction, error) {
+	options := newAggregateOptions(opts)
+
+	accounts, err := fetchAllPages(ctx, c.paginationTimeout, "link/investments/accounts.json", url.Values{}, options.perPage(),
+		listPageFetcher(c, func(res *InvestmentAccounts) []InvestmentAccount { return res.Accounts }))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get investment accounts: %w", err)
+	}
+
+	perAccount := make([][]InvestmentAccountTransaction, len(accounts))
+	err = options.run(ctx, len(accounts), func(ctx context.Context, i int) error {
+		accountKey := accounts[i].AccountKey
+		urlPath := fmt.Sprintf("link/investments/accounts/%s/transactions.json", escapePathSegment(accountKey))
+		transactions, err := fetchAllPages(ctx, c.paginationTimeout, urlPath, url.Values{}, options.perPage(),
+			listPageFetcher(c, func(res *InvestmentAccountTransactions) []InvestmentAccountTransaction { return res.Transactions }))
+		if err != nil {
+			return fmt.Errorf("failed to get transactions for investment account %s: %w", accountKey, err)
+		}
+		perAccount[i] = transactions
+		return nil
+	})
+	if err != nil && options.ErrorPolicy != ErrorPolicyCollect {
+		return nil, err
+	}
+
+	var res []InvestmentAccountTransaction
+	for _, transactions := range perAccount {
+		res = append(res, transactions...)
+	}
+	return res, err
+}
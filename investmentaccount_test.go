@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"strings"
+	"sync"
 	"testing"
 )
 
@@ -19,8 +20,8 @@ func TestGetInvestmentAccounts(t *testing.T) {
 
 		id1 := int64(123)
 		id2 := int64(456)
-		balance1 := float64Ptr(1000000.50)
-		balance2 := float64Ptr(500000.00)
+		balance1 := Float64Ptr(1000000.50)
+		balance2 := Float64Ptr(500000.00)
 		lastAggregatedAt := "2023-01-01T00:00:00Z"
 		createdAt := "2023-01-01T00:00:00Z"
 		updatedAt := "2023-01-01T00:00:00Z"
@@ -37,17 +38,17 @@ func TestGetInvestmentAccounts(t *testing.T) {
 					InstitutionEntityKey:     "test_brokerage_1",
 					InstitutionID:            1,
 					InstitutionAccountName:   "証券口座",
-					InstitutionAccountNumber: stringPtr("1234567"),
+					InstitutionAccountNumber: StringPtr("1234567"),
 					Nickname:                 "証券口座",
-					BranchName:               stringPtr("本店"),
-					BranchCode:               stringPtr("001"),
+					BranchName:               StringPtr("本店"),
+					BranchCode:               StringPtr("001"),
 					AggregationState:         "success",
 					AggregationStatus:        "success",
 					LastAggregatedAt:         lastAggregatedAt,
-					LastAggregatedSuccess:    stringPtr(lastAggregatedAt),
+					LastAggregatedSuccess:    StringPtr(lastAggregatedAt),
 					CurrentBalance:           balance1,
 					CurrentBalanceInBase:     balance1,
-					CurrentBalanceDataSource: stringPtr("institution"),
+					CurrentBalanceDataSource: StringPtr("institution"),
 					CreatedAt:                createdAt,
 					UpdatedAt:                updatedAt,
 				},
@@ -61,17 +62,17 @@ func TestGetInvestmentAccounts(t *testing.T) {
 					InstitutionEntityKey:     "test_pension_1",
 					InstitutionID:            2,
 					InstitutionAccountName:   "確定拠出年金",
-					InstitutionAccountNumber: stringPtr("9876543"),
+					InstitutionAccountNumber: StringPtr("9876543"),
 					Nickname:                 "確定拠出年金",
 					BranchName:               nil,
 					BranchCode:               nil,
 					AggregationState:         "success",
 					AggregationStatus:        "success",
 					LastAggregatedAt:         lastAggregatedAt,
-					LastAggregatedSuccess:    stringPtr(lastAggregatedAt),
+					LastAggregatedSuccess:    StringPtr(lastAggregatedAt),
 					CurrentBalance:           balance2,
 					CurrentBalanceInBase:     balance2,
-					CurrentBalanceDataSource: stringPtr("institution"),
+					CurrentBalanceDataSource: StringPtr("institution"),
 					CreatedAt:                createdAt,
 					UpdatedAt:                updatedAt,
 				},
@@ -429,6 +430,141 @@ func TestGetInvestmentAccounts(t *testing.T) {
 	})
 }
 
+func TestGetInvestmentAccount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: account is retrieved correctly", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				t.Errorf("expected method %s, got %s", http.MethodGet, r.Method)
+			}
+			if r.URL.Path != "/link/investments/accounts/account_key_123.json" {
+				t.Errorf("expected path /link/investments/accounts/account_key_123.json, got %s", r.URL.Path)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{
+				"id": 1048,
+				"account_key": "account_key_123",
+				"account_subtype": "brokerage",
+				"account_type": "stock",
+				"currency": "JPY",
+				"current_balance": 1000000.0
+			}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+		setTestToken(client, "test-access-token")
+
+		account, err := client.GetInvestmentAccount(context.Background(), "account_key_123")
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if account.ID != 1048 {
+			t.Errorf("expected ID 1048, got %d", account.ID)
+		}
+		if account.AccountKey != "account_key_123" {
+			t.Errorf("expected AccountKey account_key_123, got %s", account.AccountKey)
+		}
+		if account.AccountSubtype != AccountSubtypeBrokerage {
+			t.Errorf("expected AccountSubtype brokerage, got %s", account.AccountSubtype)
+		}
+		if account.CurrentBalance == nil || *account.CurrentBalance != 1000000.0 {
+			t.Errorf("expected CurrentBalance 1000000.0, got %v", account.CurrentBalance)
+		}
+	})
+
+	t.Run("error case: returns error when account key is empty", func(t *testing.T) {
+		t.Parallel()
+
+		baseURL, err := url.Parse("https://test.getmoneytree.com/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+		setTestToken(client, "test-token")
+
+		_, err = client.GetInvestmentAccount(context.Background(), "")
+		if err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+
+	t.Run("error case: returns ErrNotFound when the account does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error": "not_found", "error_description": "Account not found."}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+		setTestToken(client, "test-token")
+
+		_, err = client.GetInvestmentAccount(context.Background(), "missing")
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("expected ErrNotFound, got %v", err)
+		}
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected APIError, got %T", err)
+		}
+		if apiErr.StatusCode != http.StatusNotFound {
+			t.Errorf("expected status code %d, got %d", http.StatusNotFound, apiErr.StatusCode)
+		}
+	})
+
+	t.Run("error case: returns ErrNoToken when no token is set", func(t *testing.T) {
+		t.Parallel()
+
+		baseURL, err := url.Parse("https://test.getmoneytree.com/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		_, err = client.GetInvestmentAccount(context.Background(), "account_key_123")
+		if !errors.Is(err, ErrNoToken) {
+			t.Errorf("expected ErrNoToken, got %v", err)
+		}
+	})
+}
+
 func TestGetInvestmentPositions(t *testing.T) {
 	t.Parallel()
 
@@ -457,9 +593,9 @@ func TestGetInvestmentPositions(t *testing.T) {
 					ID:               id1,
 					Date:             "2023-01-01",
 					AssetClass:       "stock",
-					AssetSubclass:    stringPtr("common_stock"),
+					AssetSubclass:    StringPtr("common_stock"),
 					TickerCode:       &tickerCode1,
-					NameRaw:          stringPtr("トヨタ自動車株式会社"),
+					NameRaw:          StringPtr("トヨタ自動車株式会社"),
 					NameClean:        &nameClean1,
 					Currency:         "JPY",
 					TaxType:          taxType1,
@@ -479,11 +615,11 @@ func TestGetInvestmentPositions(t *testing.T) {
 					AssetClass:       "investment_trust",
 					AssetSubclass:    nil,
 					TickerCode:       nil,
-					NameRaw:          stringPtr("日本株式インデックスファンド"),
+					NameRaw:          StringPtr("日本株式インデックスファンド"),
 					NameClean:        &nameClean2,
 					Currency:         "JPY",
 					TaxType:          []string{"NISA"},
-					TaxSubType:       stringPtr("tsumitate"),
+					TaxSubType:       StringPtr("tsumitate"),
 					MarketValue:      marketValue2,
 					Value:            marketValue2,
 					AcquisitionValue: nil,
@@ -1325,3 +1461,316 @@ func TestGetInvestmentAccountTransactions(t *testing.T) {
 		}
 	})
 }
+
+func TestUpdateInvestmentAccountTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: transaction is updated correctly", func(t *testing.T) {
+		t.Parallel()
+
+		descriptionGuest := "新しいメモ"
+
+		expectedResponse := InvestmentAccountTransaction{
+			ID:               1337,
+			Amount:           -5000.00,
+			Date:             "2023-12-01T10:00:00Z",
+			DescriptionGuest: &descriptionGuest,
+			AccountID:        1048,
+			CategoryID:       123,
+			Attributes:       PersonalAccountTransactionAttributes{},
+			CreatedAt:        "2023-12-01T09:00:00Z",
+			UpdatedAt:        "2023-12-01T09:00:00Z",
+		}
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut {
+				t.Errorf("expected method %s, got %s", http.MethodPut, r.Method)
+			}
+			if r.URL.Path != "/link/investments/accounts/account_key_123/transactions/1337.json" {
+				t.Errorf("expected path /link/investments/accounts/account_key_123/transactions/1337.json, got %s", r.URL.Path)
+			}
+
+			var req UpdateInvestmentAccountTransactionRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Errorf("failed to decode request: %v", err)
+			}
+			if req.DescriptionGuest == nil || *req.DescriptionGuest != descriptionGuest {
+				t.Errorf("expected DescriptionGuest %s, got %v", descriptionGuest, req.DescriptionGuest)
+			}
+			if req.CategoryID == nil || *req.CategoryID != 123 {
+				t.Errorf("expected CategoryID 123, got %v", req.CategoryID)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			if err := json.NewEncoder(w).Encode(expectedResponse); err != nil {
+				t.Errorf("failed to encode response: %v", err)
+			}
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		request := &UpdateInvestmentAccountTransactionRequest{
+			DescriptionGuest: &descriptionGuest,
+			CategoryID:       Int64Ptr(123),
+		}
+
+		setTestToken(client, "test-access-token")
+		response, err := client.UpdateInvestmentAccountTransaction(context.Background(), "account_key_123", 1337, request)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+
+		if response == nil {
+			t.Fatal("expected response, got nil")
+		}
+		if response.ID != 1337 {
+			t.Errorf("expected ID 1337, got %d", response.ID)
+		}
+		if response.CategoryID != 123 {
+			t.Errorf("expected CategoryID 123, got %d", response.CategoryID)
+		}
+		if response.DescriptionGuest == nil || *response.DescriptionGuest != descriptionGuest {
+			t.Errorf("expected DescriptionGuest %s, got %v", descriptionGuest, response.DescriptionGuest)
+		}
+	})
+
+	t.Run("error case: returns error when account ID is empty", func(t *testing.T) {
+		t.Parallel()
+
+		baseURL, err := url.Parse("https://test.getmoneytree.com/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		request := &UpdateInvestmentAccountTransactionRequest{
+			DescriptionGuest: StringPtr("test"),
+		}
+
+		setTestToken(client, "test-token")
+		_, err = client.UpdateInvestmentAccountTransaction(context.Background(), "", 1337, request)
+		if err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+
+	t.Run("error case: returns error when request is nil", func(t *testing.T) {
+		t.Parallel()
+
+		baseURL, err := url.Parse("https://test.getmoneytree.com/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		setTestToken(client, "test-token")
+		_, err = client.UpdateInvestmentAccountTransaction(context.Background(), "account_key_123", 1337, nil)
+		if err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+
+	t.Run("error case: returns error when description_guest exceeds 255 characters", func(t *testing.T) {
+		t.Parallel()
+
+		baseURL, err := url.Parse("https://test.getmoneytree.com/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		longDescription := strings.Repeat("a", 256)
+		request := &UpdateInvestmentAccountTransactionRequest{
+			DescriptionGuest: &longDescription,
+		}
+
+		setTestToken(client, "test-token")
+		_, err = client.UpdateInvestmentAccountTransaction(context.Background(), "account_key_123", 1337, request)
+		if err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+
+	t.Run("error case: returns error when API returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error": "invalid_request", "error_description": "Category ID does not exist."}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		request := &UpdateInvestmentAccountTransactionRequest{
+			CategoryID: Int64Ptr(99999),
+		}
+
+		setTestToken(client, "test-token")
+		_, err = client.UpdateInvestmentAccountTransaction(context.Background(), "account_key_123", 1337, request)
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected APIError, got %T", err)
+		}
+		if apiErr.StatusCode != http.StatusBadRequest {
+			t.Errorf("expected status code %d, got %d", http.StatusBadRequest, apiErr.StatusCode)
+		}
+	})
+}
+
+func TestGetAllInvestmentAccountTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: transactions of two accounts are merged across pages", func(t *testing.T) {
+		t.Parallel()
+
+		var mu sync.Mutex
+		var requestedPages []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Query().Get("per_page") != "2" {
+				t.Errorf("expected per_page 2, got %s", r.URL.Query().Get("per_page"))
+			}
+			page := r.URL.Query().Get("page")
+			mu.Lock()
+			requestedPages = append(requestedPages, r.URL.Path+"?page="+page)
+			mu.Unlock()
+			switch r.URL.Path + "?page=" + page {
+			case "/link/investments/accounts.json?page=1":
+				_, _ = w.Write([]byte(`{"accounts": [{"account_key": "investment_a"}, {"account_key": "investment_b"}]}`))
+			case "/link/investments/accounts.json?page=2":
+				_, _ = w.Write([]byte(`{"accounts": []}`))
+			case "/link/investments/accounts/investment_a/transactions.json?page=1":
+				_, _ = w.Write([]byte(`{"transactions": [{"id": 1, "account_id": 10}, {"id": 2, "account_id": 10}]}`))
+			case "/link/investments/accounts/investment_a/transactions.json?page=2":
+				_, _ = w.Write([]byte(`{"transactions": [{"id": 3, "account_id": 10}]}`))
+			case "/link/investments/accounts/investment_b/transactions.json?page=1":
+				_, _ = w.Write([]byte(`{"transactions": [{"id": 4, "account_id": 20}]}`))
+			default:
+				t.Errorf("unexpected request %s?page=%s", r.URL.Path, page)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		setTestToken(client, "test-access-token")
+		transactions, err := client.GetAllInvestmentAccountTransactions(context.Background(), WithPerPageForAggregates(2))
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+
+		expectedIDs := []int64{1, 2, 3, 4}
+		if len(transactions) != len(expectedIDs) {
+			t.Fatalf("expected %d transactions, got %d", len(expectedIDs), len(transactions))
+		}
+		for i, expectedID := range expectedIDs {
+			if transactions[i].ID != expectedID {
+				t.Errorf("expected transaction[%d].ID %d, got %d", i, expectedID, transactions[i].ID)
+			}
+		}
+		if len(requestedPages) != 5 {
+			t.Errorf("expected 5 requests, got %v", requestedPages)
+		}
+	})
+
+	t.Run("error case: returns error naming the account whose transactions fail", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/link/investments/accounts.json":
+				_, _ = w.Write([]byte(`{"accounts": [{"account_key": "investment_a"}, {"account_key": "investment_b"}]}`))
+			case "/link/investments/accounts/investment_a/transactions.json":
+				_, _ = w.Write([]byte(`{"transactions": [{"id": 1}]}`))
+			default:
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"error": "insufficient_scope"}`))
+			}
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		setTestToken(client, "test-access-token")
+		transactions, err := client.GetAllInvestmentAccountTransactions(context.Background())
+		if err == nil || !strings.Contains(err.Error(), "investment_b") {
+			t.Fatalf("expected error naming investment_b, got %v", err)
+		}
+		if transactions != nil {
+			t.Errorf("expected nil transactions, got %v", transactions)
+		}
+
+		transactions, err = client.GetAllInvestmentAccountTransactions(context.Background(), WithErrorPolicy(ErrorPolicyCollect))
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if len(transactions) != 1 || transactions[0].ID != 1 {
+			t.Errorf("expected the transactions of investment_a, got %v", transactions)
+		}
+	})
+}
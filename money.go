@@ -0,0 +1,151 @@
+package moneytree
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Money is an amount of money in a currency, stored as an integer number of minor units
+// (e.g., yen for JPY, cents for USD), so that sums of many amounts are exact.
+// The API returns amounts as float64; use NewMoney to convert them, or the AmountMoney accessors
+// of the transaction types. The zero value is zero in no currency.
+//
+// Example:
+//
+//	var total moneytree.Money
+//	for _, transaction := range response.Transactions {
+//		var err error
+//		total, err = total.Add(transaction.AmountMoney("JPY"))
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//	}
+//	fmt.Println(total) // e.g. "-123456 JPY"
+type Money struct {
+	minorUnits int64
+	currency   string
+}
+
+// NewMoney converts an amount returned by the API to Money in currency, an ISO 4217 code such as "JPY".
+// The amount is rounded half away from zero to the minor unit of the currency (see MinorUnitDigits).
+// Amounts beyond the range of int64 minor units are not supported.
+func NewMoney(amount float64, currency string) Money {
+	scale := math.Pow10(MinorUnitDigits(currency))
+	return Money{minorUnits: int64(math.Round(amount * scale)), currency: currency}
+}
+
+// NewMoneyFromMinorUnits returns Money of minorUnits minor units in currency,
+// e.g. NewMoneyFromMinorUnits(1234, "USD") for 12.34 USD.
+func NewMoneyFromMinorUnits(minorUnits int64, currency string) Money {
+	return Money{minorUnits: minorUnits, currency: currency}
+}
+
+// MinorUnitDigits returns the number of decimal digits of the minor unit of currency, an ISO 4217 code:
+// 0 for currencies without a minor unit such as JPY and KRW, 3 for currencies such as BHD and KWD,
+// and 2 otherwise, including for an empty or unknown code.
+func MinorUnitDigits(currency string) int {
+	switch strings.ToUpper(currency) {
+	case "BIF", "CLP", "DJF", "GNF", "ISK", "JPY", "KMF", "KRW", "PYG", "RWF", "UGX", "UYI", "VND", "VUV", "XAF", "XOF", "XPF":
+		return 0
+	case "BHD", "IQD", "JOD", "KWD", "LYD", "OMR", "TND":
+		return 3
+	default:
+		return 2
+	}
+}
+
+// Currency returns the ISO 4217 currency code of m.
+func (m Money) Currency() string {
+	return m.currency
+}
+
+// MinorUnits returns the amount of m as an integer number of minor units of its currency.
+func (m Money) MinorUnits() int64 {
+	return m.minorUnits
+}
+
+// Float64 returns the amount of m in major units as the API represents it, e.g. 12.34 for 1234 cents.
+func (m Money) Float64() float64 {
+	return float64(m.minorUnits) / math.Pow10(MinorUnitDigits(m.currency))
+}
+
+// IsZero reports whether the amount of m is zero, whatever its currency.
+func (m Money) IsZero() bool {
+	return m.minorUnits == 0
+}
+
+// Add returns the sum of m and other.
+// The zero value of Money takes the currency of the other operand, so that sums can start from it;
+// otherwise, an error wrapping ErrCurrencyMismatch is returned if the currencies differ.
+func (m Money) Add(other Money) (Money, error) {
+	currency, err := m.commonCurrency(other)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{minorUnits: m.minorUnits + other.minorUnits, currency: currency}, nil
+}
+
+// Sub returns the difference of m and other.
+// Currencies are handled as in Add.
+func (m Money) Sub(other Money) (Money, error) {
+	currency, err := m.commonCurrency(other)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{minorUnits: m.minorUnits - other.minorUnits, currency: currency}, nil
+}
+
+// commonCurrency returns the currency of the result of an operation on m and other.
+func (m Money) commonCurrency(other Money) (string, error) {
+	switch {
+	case m == Money{}:
+		return other.currency, nil
+	case other == Money{}:
+		return m.currency, nil
+	case !strings.EqualFold(m.currency, other.currency):
+		return "", fmt.Errorf("failed to combine %s with %s: %w", m.currency, other.currency, ErrCurrencyMismatch)
+	default:
+		return m.currency, nil
+	}
+}
+
+// String formats m with the number of decimals of its currency followed by the currency code,
+// e.g. "-1234 JPY" or "12.34 USD". The currency code is omitted if it is empty.
+func (m Money) String() string {
+	digits := MinorUnitDigits(m.currency)
+
+	var b strings.Builder
+	abs := uint64(m.minorUnits)
+	if m.minorUnits < 0 {
+		b.WriteByte('-')
+		abs = -abs
+	}
+	s := strconv.FormatUint(abs, 10)
+	if digits > 0 {
+		if len(s) <= digits {
+			s = strings.Repeat("0", digits-len(s)+1) + s
+		}
+		s = s[:len(s)-digits] + "." + s[len(s)-digits:]
+	}
+	b.WriteString(s)
+	if m.currency != "" {
+		b.WriteByte(' ')
+		b.WriteString(m.currency)
+	}
+	return b.String()
+}
+
+// AmountMoney returns the amount of the transaction as Money in currency.
+// Transactions do not carry a currency: pass the currency of their account,
+// e.g. the Currency of the PersonalAccount.
+func (t PersonalAccountTransaction) AmountMoney(currency string) Money {
+	return NewMoney(t.Amount, currency)
+}
+
+// AmountMoney returns the amount of the transaction as Money in currency.
+// Transactions do not carry a currency: pass the Currency of the CorporateAccount.
+func (t CorporateAccountTransaction) AmountMoney(currency string) Money {
+	return NewMoney(t.Amount, currency)
+}
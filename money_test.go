@@ -0,0 +1,95 @@
+package moneytree
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewMoney(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		amount     float64
+		currency   string
+		minorUnits int64
+		str        string
+	}{
+		{name: "success case: JPY has no decimals", amount: -5000, currency: "JPY", minorUnits: -5000, str: "-5000 JPY"},
+		{name: "success case: USD has two decimals", amount: 12.34, currency: "USD", minorUnits: 1234, str: "12.34 USD"},
+		{name: "success case: amounts are rounded half away from zero", amount: -0.125, currency: "USD", minorUnits: -13, str: "-0.13 USD"},
+		{name: "success case: KWD has three decimals", amount: 1.5, currency: "KWD", minorUnits: 1500, str: "1.500 KWD"},
+		{name: "success case: small amounts are padded", amount: 0.05, currency: "EUR", minorUnits: 5, str: "0.05 EUR"},
+		{name: "success case: empty currency has two decimals", amount: 3, currency: "", minorUnits: 300, str: "3.00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := NewMoney(tt.amount, tt.currency)
+			if m.MinorUnits() != tt.minorUnits {
+				t.Errorf("expected %d minor units, got %d", tt.minorUnits, m.MinorUnits())
+			}
+			if m.Currency() != tt.currency {
+				t.Errorf("expected currency %q, got %q", tt.currency, m.Currency())
+			}
+			if got := m.String(); got != tt.str {
+				t.Errorf("expected %q, got %q", tt.str, got)
+			}
+		})
+	}
+}
+
+func TestMoney_Add(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: sums are exact where float64 sums are not", func(t *testing.T) {
+		t.Parallel()
+
+		var total Money
+		var floatTotal float64
+		for range 1000 {
+			transaction := PersonalAccountTransaction{Amount: 0.1}
+			var err error
+			total, err = total.Add(transaction.AmountMoney("USD"))
+			if err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+			floatTotal += transaction.Amount
+		}
+		if floatTotal == 100 {
+			t.Fatal("expected the float64 sum to be inexact")
+		}
+		if total != NewMoneyFromMinorUnits(10000, "USD") {
+			t.Errorf("expected 100.00 USD, got %s", total)
+		}
+		if total.Float64() != 100 {
+			t.Errorf("expected 100, got %v", total.Float64())
+		}
+	})
+
+	t.Run("success case: Sub subtracts amounts", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := NewMoney(1000, "JPY").Sub(NewMoney(1500, "JPY"))
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if got.String() != "-500 JPY" {
+			t.Errorf("expected -500 JPY, got %s", got)
+		}
+	})
+
+	t.Run("error case: currencies differ", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewMoney(1000, "JPY").Add(NewMoney(10, "USD"))
+		if !errors.Is(err, ErrCurrencyMismatch) {
+			t.Errorf("expected ErrCurrencyMismatch, got %v", err)
+		}
+		_, err = NewMoney(1000, "JPY").Sub(NewMoney(10, "USD"))
+		if !errors.Is(err, ErrCurrencyMismatch) {
+			t.Errorf("expected ErrCurrencyMismatch, got %v", err)
+		}
+	})
+}
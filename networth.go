@@ -0,0 +1,126 @@
+package moneytree
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+)
+
+// NetWorthSummary represents the net worth of a guest computed from the balances of their personal accounts.
+type NetWorthSummary struct {
+	// ByCurrency is the sum of the account balances per currency code (e.g., "JPY", "USD").
+	// Debts such as credit card balances are included as reported by the API.
+	// Accounts without a currency are summed under the empty string key.
+	ByCurrency map[string]float64
+	// AccountCount is the number of accounts whose balance is included in ByCurrency.
+	AccountCount int
+	// SkippedAccounts is the number of accounts excluded because their balance could not be retrieved.
+	SkippedAccounts int
+}
+
+// NetWorth computes the net worth of the guest from the balances of all personal accounts.
+// This endpoint requires the accounts_read OAuth scope.
+//
+// Every page of the personal accounts list is fetched. Balances are summed per currency,
+// and accounts whose balance is nil are counted in SkippedAccounts.
+//
+// Example:
+//
+//	summary, err := client.NetWorth(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Net worth: %v JPY (%d accounts skipped)\n", summary.ByCurrency["JPY"], summary.SkippedAccounts)
+func (c *Client) NetWorth(ctx context.Context) (*NetWorthSummary, error) {
+	accounts, err := fetchAllPages(ctx, c.paginationTimeout, "link/accounts.json", url.Values{}, maxPerPage,
+		listPageFetcher(c, func(res *PersonalAccounts) []PersonalAccount { return res.Accounts }))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get personal accounts: %w", err)
+	}
+
+	summary := &NetWorthSummary{ByCurrency: make(map[string]float64)}
+	for _, account := range accounts {
+		if account.Balance == nil {
+			summary.SkippedAccounts++
+			continue
+		}
+		currency := ""
+		if account.Currency != nil {
+			currency = *account.Currency
+		}
+		summary.ByCurrency[currency] += *account.Balance
+		summary.AccountCount++
+	}
+	return summary, nil
+}
+
+// NetWorthForTokens computes the net worth of several guests, one per access token.
+// This endpoint requires the accounts_read OAuth scope.
+//
+// Each guest is processed with NetWorth, authenticating every request with that guest's
+// access token (see WithAccessToken), so the token set on the Client is neither used nor modified.
+// Up to WithConcurrency guests are processed at the same time.
+//
+// A failure for one guest does not stop the others: the successful summaries and the errors
+// are returned in separate maps keyed by access token. Both maps are always non-nil.
+// Duplicate tokens are processed once. As errors are always collected per token,
+// WithErrorPolicy has no effect on this helper.
+//
+// Example:
+//
+//	summaries, errs := client.NetWorthForTokens(ctx, accessTokens, moneytree.WithConcurrency(2))
+//	for _, err := range errs {
+//		log.Printf("failed to compute net worth: %v", err)
+//	}
+//	for _, summary := range summaries {
+//		fmt.Printf("%v\n", summary.ByCurrency)
+//	}
+func (c *Client) NetWorthForTokens(ctx context.Context, tokens []string, opts ...AggregateOption) (map[string]*NetWorthSummary, map[string]error) {
+	options := newAggregateOptions(opts)
+
+	summaries := make(map[string]*NetWorthSummary)
+	errs := make(map[string]error)
+
+	var unique []string
+	seen := make(map[string]bool)
+	for _, token := range tokens {
+		if seen[token] {
+			continue
+		}
+		seen[token] = true
+		if token == "" {
+			// An empty token would fall back to the Client's token, mixing up guests.
+			errs[token] = fmt.Errorf("access token is required")
+			continue
+		}
+		unique = append(unique, token)
+	}
+
+	var mu sync.Mutex
+	err := runConcurrently(ctx, len(unique), options.Concurrency, func(ctx context.Context, i int) error {
+		token := unique[i]
+		summary, err := c.NetWorth(WithCallOptions(ctx, WithAccessToken(token)))
+
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			errs[token] = err
+		} else {
+			summaries[token] = summary
+		}
+		// Errors are collected per token so that one failing guest does not cancel the others.
+		return nil
+	})
+	if err != nil {
+		// The context was canceled before some tokens were processed.
+		for _, token := range unique {
+			if _, ok := summaries[token]; !ok {
+				if _, ok := errs[token]; !ok {
+					errs[token] = err
+				}
+			}
+		}
+	}
+	return summaries, errs
+}
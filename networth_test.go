@@ -0,0 +1,134 @@
+package moneytree
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestNetWorth(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: balances are summed per currency and nil balances are skipped", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/link/accounts.json" {
+				t.Errorf("expected path /link/accounts.json, got %s", r.URL.Path)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"accounts": [
+				{"account_key": "a", "balance": 1000, "currency": "JPY"},
+				{"account_key": "b", "balance": -300, "currency": "JPY"},
+				{"account_key": "c", "balance": 10.5, "currency": "USD"},
+				{"account_key": "d", "balance": null, "currency": "JPY"}
+			]}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		setTestToken(client, "test-access-token")
+		summary, err := client.NetWorth(context.Background())
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+
+		if summary.ByCurrency["JPY"] != 700 {
+			t.Errorf("expected JPY net worth 700, got %v", summary.ByCurrency["JPY"])
+		}
+		if summary.ByCurrency["USD"] != 10.5 {
+			t.Errorf("expected USD net worth 10.5, got %v", summary.ByCurrency["USD"])
+		}
+		if summary.AccountCount != 3 {
+			t.Errorf("expected 3 accounts, got %d", summary.AccountCount)
+		}
+		if summary.SkippedAccounts != 1 {
+			t.Errorf("expected 1 skipped account, got %d", summary.SkippedAccounts)
+		}
+	})
+}
+
+func TestNetWorthForTokens(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: summaries and errors are keyed by token", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.Header.Get("Authorization") {
+			case "Bearer guest-a-token":
+				_, _ = w.Write([]byte(`{"accounts": [{"account_key": "a", "balance": 1000, "currency": "JPY"}]}`))
+			case "Bearer guest-b-token":
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"error": "invalid_token"}`))
+			default:
+				t.Errorf("unexpected Authorization header %s", r.Header.Get("Authorization"))
+				w.WriteHeader(http.StatusUnauthorized)
+			}
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		// The client's own token must not be used or refreshed.
+		setTestToken(client, "client-token")
+		summaries, errs := client.NetWorthForTokens(context.Background(), []string{"guest-a-token", "guest-b-token", "guest-a-token"})
+
+		if len(summaries) != 1 {
+			t.Fatalf("expected 1 summary, got %d", len(summaries))
+		}
+		if summaries["guest-a-token"].ByCurrency["JPY"] != 1000 {
+			t.Errorf("expected JPY net worth 1000, got %v", summaries["guest-a-token"].ByCurrency["JPY"])
+		}
+
+		if len(errs) != 1 {
+			t.Fatalf("expected 1 error, got %d", len(errs))
+		}
+		var apiErr *APIError
+		if !errors.As(errs["guest-b-token"], &apiErr) || !apiErr.IsUnauthorized() {
+			t.Errorf("expected unauthorized APIError, got %v", errs["guest-b-token"])
+		}
+	})
+
+	t.Run("error case: empty token is reported as an error", func(t *testing.T) {
+		t.Parallel()
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+		}
+
+		summaries, errs := client.NetWorthForTokens(context.Background(), []string{""})
+
+		if len(summaries) != 0 {
+			t.Errorf("expected 0 summaries, got %d", len(summaries))
+		}
+		if errs[""] == nil {
+			t.Error("expected error for empty token, got nil")
+		}
+	})
+}
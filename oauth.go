@@ -41,27 +41,68 @@ type OauthToken struct {
 	RefreshToken   *string `json:"refresh_token,omitempty"`
 	Scope          *string `json:"scope,omitempty"`
 	ResourceServer *string `json:"resource_server,omitempty"`
+
+	// nonExpiring marks a token seeded by WithInitialToken without an expiry,
+	// which is valid until the API rejects it with 401 Unauthorized.
+	nonExpiring bool
 }
 
+// defaultTokenRefreshSkew is how long before its expiry a token is refreshed by default.
+const defaultTokenRefreshSkew = time.Minute
+
 // Valid checks if the token is valid (not expired).
 // It returns true if the token has an access token and is not expired.
 // The token is considered expired if CreatedAt + ExpiresIn is before the current time.
 // A buffer time of 1 minute is used to account for clock skew and network delays.
 func (t *OauthToken) Valid() bool {
+	return t.validAt(time.Now(), defaultTokenRefreshSkew)
+}
+
+// Expiry returns the time at which the access token expires, CreatedAt + ExpiresIn.
+// The returned bool is false if the token does not tell its expiry, i.e. CreatedAt or ExpiresIn
+// is nil, or the token was seeded by WithInitialToken without an expiry.
+func (t *OauthToken) Expiry() (time.Time, bool) {
+	if t == nil || t.nonExpiring || t.CreatedAt == nil || t.ExpiresIn == nil {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(*t.CreatedAt), 0).Add(time.Duration(*t.ExpiresIn) * time.Second), true
+}
+
+// validAt reports whether the token is valid at now, treating it as expired skew before its expiry
+// to account for clock skew and network delays. See Valid.
+func (t *OauthToken) validAt(now time.Time, skew time.Duration) bool {
 	if t == nil {
 		return false
 	}
 	if t.AccessToken == nil {
 		return false
 	}
-	if t.CreatedAt == nil || t.ExpiresIn == nil {
+	if t.nonExpiring {
+		return true
+	}
+	expiresAt, ok := t.Expiry()
+	if !ok {
 		return false
 	}
-	// Calculate expiration time: CreatedAt (Unix timestamp) + ExpiresIn (seconds)
-	expiresAt := time.Unix(int64(*t.CreatedAt), 0).Add(time.Duration(*t.ExpiresIn) * time.Second)
-	// Use a 1-minute buffer to account for clock skew and network delays
-	bufferTime := 1 * time.Minute
-	return time.Now().Add(bufferTime).Before(expiresAt)
+	return now.Add(skew).Before(expiresAt)
+}
+
+// MaskToken returns token with all but its first and last 4 characters replaced by asterisks,
+// e.g. "test****oken", so that a token can be told apart in logs without being leaked.
+// Tokens of 8 characters or fewer are masked entirely.
+//
+// The errors returned by Client never contain the tokens: they are only sent in the Authorization
+// header, or in the form body of the OAuth endpoints, never in a URL.
+//
+// Example:
+//
+//	log.Printf("using access token %s", moneytree.MaskToken(*token.AccessToken))
+func MaskToken(token string) string {
+	const visible = 4
+	if len(token) <= 2*visible {
+		return strings.Repeat("*", len(token))
+	}
+	return token[:visible] + strings.Repeat("*", len(token)-2*visible) + token[len(token)-visible:]
 }
 
 // RevokeTokenRequest represents a request to revoke an access token or refresh token.
@@ -77,6 +118,9 @@ func (c *Client) RetrieveToken(ctx context.Context, req *RetrieveTokenRequest) (
 	if req == nil {
 		return nil, fmt.Errorf("request cannot be nil")
 	}
+	if c.config == nil {
+		return nil, ErrClientNotConfigured
+	}
 	body := retrieveTokenRequest{
 		RetrieveTokenRequest: *req,
 		ClientID:             c.config.ClientID,
@@ -106,6 +150,9 @@ func (c *Client) RevokeToken(ctx context.Context, req *RevokeTokenRequest) error
 	if req.Token == "" {
 		return fmt.Errorf("token is required")
 	}
+	if c.config == nil {
+		return ErrClientNotConfigured
+	}
 
 	form := url.Values{}
 	form.Set("token", req.Token)
@@ -141,13 +188,77 @@ func (c *Client) SetToken(token *OauthToken) {
 	c.getTokenErr = nil
 }
 
-// sleepWithContext sleeps for the specified duration, but returns early if the context is canceled.
-func sleepWithContext(ctx context.Context, d time.Duration) error {
-	select {
-	case <-ctx.Done():
-		return ctx.Err()
-	case <-time.After(d):
-		return nil
+// WithToken returns a new Client that authenticates its requests with accessToken, so that a single
+// configured Client can serve many guests. The new Client shares the HTTP client, its connection pool,
+// the Config and the other settings of c; c itself is not modified, and WithToken may be called concurrently.
+//
+// The token is used as is until the API rejects it with 401 Unauthorized, as with WithInitialToken
+// and a zero expiry: it is not refreshed. If accessToken is empty, the new Client has no token and its
+// calls return ErrNoToken until SetToken is called. The new Client has its own Stats, and its Close
+// does nothing, as the HTTP client belongs to c. To use a different token for a single call instead,
+// see WithAccessToken.
+//
+// Example:
+//
+//	guestClient := client.WithToken(guestAccessToken)
+//	response, err := guestClient.GetPersonalAccounts(ctx)
+func (c *Client) WithToken(accessToken string) *Client {
+	// Every field but the per-token state and the ownership of the HTTP client is copied.
+	// TestClient_WithToken fails if a new field of Client is neither copied here nor listed as not copied.
+	clone := &Client{
+		httpClient:             c.httpClient,
+		config:                 c.config,
+		retryConfig:            c.retryConfig,
+		clock:                  c.clock,
+		debugWriter:            c.debugWriter,
+		maxBodyLogBytes:        c.maxBodyLogBytes,
+		prettyPrintRequestBody: c.prettyPrintRequestBody,
+		flights:                c.flights,
+		beforeCall:             c.beforeCall,
+		afterCall:              c.afterCall,
+		paginationTimeout:      c.paginationTimeout,
+		applicationID:          c.applicationID,
+		maxResponseTime:        c.maxResponseTime,
+		tokenRefreshSkew:       c.tokenRefreshSkew,
+	}
+	if accessToken != "" {
+		clone.token = &OauthToken{AccessToken: &accessToken, nonExpiring: true}
+	}
+	return clone
+}
+
+// TokenExpiry returns the expiry of the token set on the Client (see OauthToken.Expiry), e.g. to
+// monitor token lifetimes. The returned bool is false if no token is set or its expiry is unknown.
+// The token is refreshed before each request once its expiry is within the skew set by
+// WithTokenRefreshSkew, so the token may be replaced before the returned time.
+//
+// Example:
+//
+//	if expiry, ok := client.TokenExpiry(); ok {
+//		fmt.Printf("token expires in %v\n", time.Until(expiry))
+//	}
+func (c *Client) TokenExpiry() (time.Time, bool) {
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+	return c.token.Expiry()
+}
+
+// refreshSkew returns how long before its expiry the token is refreshed.
+func (c *Client) refreshSkew() time.Duration {
+	if c.tokenRefreshSkew == nil {
+		return defaultTokenRefreshSkew
+	}
+	return *c.tokenRefreshSkew
+}
+
+// dropRejectedToken discards the client's token if it is a non-expiring token seeded by WithInitialToken
+// and the API rejected accessToken with 401 Unauthorized, so that the following calls return ErrNoToken
+// instead of sending it again.
+func (c *Client) dropRejectedToken(accessToken string) {
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+	if c.token != nil && c.token.nonExpiring && c.token.AccessToken != nil && *c.token.AccessToken == accessToken {
+		c.token = nil
 	}
 }
 
@@ -161,7 +272,7 @@ func (c *Client) refreshToken(ctx context.Context) error {
 	for i := 0; i < maxAttempts; i++ {
 		// Check if token is valid without locking (read-only check)
 		c.tokenMutex.Lock()
-		tokenValid := c.token.Valid()
+		tokenValid := c.token.validAt(c.getClock().Now(), c.refreshSkew())
 		getTokenErr := c.getTokenErr
 		c.tokenMutex.Unlock()
 
@@ -180,26 +291,24 @@ func (c *Client) refreshToken(ctx context.Context) error {
 			defer c.tokenMutex.Unlock()
 
 			// Double-check after acquiring the lock
-			if c.token.Valid() {
+			if c.token.validAt(c.getClock().Now(), c.refreshSkew()) {
 				return nil
 			}
 			if c.getTokenErr != nil {
 				return c.getTokenErr
 			}
 
-			// Refresh the token using refresh_token grant type
-			if c.token == nil {
-				c.getTokenErr = fmt.Errorf("token is not set: call SetToken() with a token obtained from RetrieveToken()")
-				return c.getTokenErr
-			}
-			if c.token.RefreshToken == nil {
+			// Refresh the token using refresh_token grant type.
+			// Without a token or a refresh token, there is nothing to refresh, whatever the state.
+			if c.token == nil || c.token.RefreshToken == nil {
 				c.token = nil
-				c.getTokenErr = fmt.Errorf("no refresh token available: the current token does not have a refresh token")
+				c.getTokenErr = ErrNoToken
 				return c.getTokenErr
 			}
 
 			grantType := "refresh_token"
-			token, err := c.RetrieveToken(ctx, &RetrieveTokenRequest{
+			// The refresh is part of the call that needs it, whose hooks already fire.
+			token, err := c.RetrieveToken(WithCallOptions(ctx, withoutHooks()), &RetrieveTokenRequest{
 				GrantType:    &grantType,
 				RefreshToken: c.token.RefreshToken,
 			})
@@ -217,7 +326,7 @@ func (c *Client) refreshToken(ctx context.Context) error {
 		// Another goroutine is refreshing the token, wait a bit and retry
 		// nolint:gosec // G404: Using math/rand is acceptable for wait time jitter (not security-sensitive)
 		waitTime := time.Duration(100+rand.Intn(100)) * time.Millisecond
-		if err := sleepWithContext(ctx, waitTime); err != nil {
+		if err := c.getClock().Sleep(ctx, waitTime); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,419 @@
+package moneytree
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestErrNoToken(t *testing.T) {
+	t.Parallel()
+
+	baseURL, err := url.Parse("https://test.getmoneytree.com/")
+	if err != nil {
+		t.Fatalf("failed to parse base URL: %v", err)
+	}
+
+	expiredAccessToken := "expired-access-token"
+	createdAt := int(time.Now().Add(-2 * time.Hour).Unix())
+	expiresIn := 3600
+
+	clients := []struct {
+		name   string
+		client func() *Client
+	}{
+		{
+			name: "token is not set",
+			client: func() *Client {
+				return &Client{config: &Config{BaseURL: baseURL}}
+			},
+		},
+		{
+			name: "token has expired without a refresh token",
+			client: func() *Client {
+				client := &Client{config: &Config{BaseURL: baseURL}}
+				setTestToken(client, "unused")
+				client.SetToken(&OauthToken{AccessToken: &expiredAccessToken, CreatedAt: &createdAt, ExpiresIn: &expiresIn})
+				return client
+			},
+		},
+	}
+	methods := []struct {
+		name string
+		call func(c *Client) error
+	}{
+		{name: "GetProfile", call: func(c *Client) error { _, err := c.GetProfile(context.Background()); return err }},
+		{name: "GetPersonalAccounts", call: func(c *Client) error { _, err := c.GetPersonalAccounts(context.Background()); return err }},
+		{name: "GetCategories", call: func(c *Client) error { _, err := c.GetCategories(context.Background()); return err }},
+		{name: "AllAccounts", call: func(c *Client) error { _, err := c.AllAccounts(context.Background()); return err }},
+	}
+
+	for _, tc := range clients {
+		for _, method := range methods {
+			t.Run("error case: "+method.name+" when "+tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				client := tc.client()
+				// The error must stay the same on subsequent calls.
+				for i := 0; i < 2; i++ {
+					err := method.call(client)
+					if !errors.Is(err, ErrNoToken) {
+						t.Fatalf("call %d: expected ErrNoToken, got %v", i+1, err)
+					}
+				}
+			})
+		}
+	}
+
+	t.Run("error case: the message is the same for every single-request method", func(t *testing.T) {
+		t.Parallel()
+
+		for _, method := range methods[:3] {
+			err := method.call(&Client{config: &Config{BaseURL: baseURL}})
+			if err == nil || err.Error() != ErrNoToken.Error() {
+				t.Errorf("%s: expected %q, got %v", method.name, ErrNoToken.Error(), err)
+			}
+		}
+	})
+}
+
+func TestWithTokenRefreshSkew(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		opts              []NewClientOption
+		expectedRefreshes int32
+	}{
+		{name: "success case: a token expiring after the default skew is not refreshed", expectedRefreshes: 0},
+		{name: "success case: a token expiring within the skew is refreshed once by concurrent calls", opts: []NewClientOption{WithTokenRefreshSkew(5 * time.Minute)}, expectedRefreshes: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var refreshes atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.URL.Path == "/oauth/token" {
+					refreshes.Add(1)
+					_, _ = w.Write([]byte(`{"access_token": "new-access-token", "refresh_token": "new-refresh-token", "created_at": 1700000000, "expires_in": 7200}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"email": "user@example.com"}`))
+			}))
+			defer server.Close()
+
+			baseURL, err := url.Parse(server.URL + "/")
+			if err != nil {
+				t.Fatalf("failed to parse base URL: %v", err)
+			}
+
+			now := time.Unix(1700000000, 0)
+			client, err := NewClient("jp-api-staging", append(tt.opts, withClockForTesting(newFakeClock(now)))...)
+			if err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+			client.config.BaseURL = baseURL
+			// The token expires in 3 minutes.
+			accessToken, refreshToken := "old-access-token", "old-refresh-token"
+			createdAt, expiresIn := int(now.Unix()), 180
+			client.SetToken(&OauthToken{AccessToken: &accessToken, RefreshToken: &refreshToken, CreatedAt: &createdAt, ExpiresIn: &expiresIn})
+
+			var wg sync.WaitGroup
+			for range 5 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if _, err := client.GetProfile(context.Background()); err != nil {
+						t.Errorf("expected nil, got %v", err)
+					}
+				}()
+			}
+			wg.Wait()
+
+			if got := refreshes.Load(); got != tt.expectedRefreshes {
+				t.Errorf("expected %d refreshes, got %d", tt.expectedRefreshes, got)
+			}
+		})
+	}
+
+	t.Run("error case: a negative skew", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := NewClient("jp-api-staging", WithTokenRefreshSkew(-time.Second)); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
+
+func TestClient_TokenExpiry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: the expiry of the token set is returned", func(t *testing.T) {
+		t.Parallel()
+
+		client := &Client{}
+		accessToken := "access-token"
+		createdAt, expiresIn := 1700000000, 3600
+		client.SetToken(&OauthToken{AccessToken: &accessToken, CreatedAt: &createdAt, ExpiresIn: &expiresIn})
+
+		expiry, ok := client.TokenExpiry()
+		if !ok {
+			t.Fatal("expected the expiry to be known")
+		}
+		if expected := time.Unix(1700003600, 0); !expiry.Equal(expected) {
+			t.Errorf("expected %v, got %v", expected, expiry)
+		}
+	})
+
+	t.Run("success case: the expiry is unknown without a token", func(t *testing.T) {
+		t.Parallel()
+
+		if _, ok := (&Client{}).TokenExpiry(); ok {
+			t.Error("expected the expiry to be unknown")
+		}
+	})
+}
+
+func TestClient_WithToken(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: each clone authenticates with its own token", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"email": "` + r.Header.Get("Authorization") + `"}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+		setTestToken(client, "shared-token")
+
+		var wg sync.WaitGroup
+		for i := range 10 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				token := fmt.Sprintf("guest-token-%d", i)
+				guestClient := client.WithToken(token)
+				if guestClient.httpClient != client.httpClient || guestClient.config != client.config {
+					t.Error("expected the clone to share the HTTP client and Config")
+				}
+				profile, err := guestClient.GetProfile(context.Background())
+				if err != nil {
+					t.Errorf("expected nil, got %v", err)
+					return
+				}
+				if profile.Email != "Bearer "+token {
+					t.Errorf("expected response for %s, got %s", token, profile.Email)
+				}
+			}()
+		}
+		wg.Wait()
+
+		profile, err := client.GetProfile(context.Background())
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if profile.Email != "Bearer shared-token" {
+			t.Errorf("expected the receiver to keep its token, got %s", profile.Email)
+		}
+	})
+
+	t.Run("success case: the clone copies every setting of the receiver", func(t *testing.T) {
+		t.Parallel()
+
+		// Fields that WithToken deliberately does not copy. A new field of Client must either be
+		// copied by WithToken or be listed here.
+		notCopied := map[string]bool{
+			"token":          true,
+			"tokenMutex":     true,
+			"getTokenErr":    true,
+			"ownsHTTPClient": true,
+			"stats":          true,
+			"optionErr":      true,
+		}
+
+		client, err := NewClient("jp-api-staging",
+			WithDebug(io.Discard),
+			WithSingleFlight(),
+			WithApplicationID("app"),
+			WithPaginationTimeout(time.Minute),
+			WithMaxResponseTime(time.Minute),
+			WithTokenRefreshSkew(time.Second),
+			WithBeforeCall(func(*http.Request) {}),
+			WithAfterCall(func(*http.Request, *http.Response, error) {}),
+			withClockForTesting(newFakeClock(time.Now())),
+		)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		client.maxBodyLogBytes = 10
+		client.prettyPrintRequestBody = true
+
+		// same reports whether b holds the same value as a, comparing references by identity.
+		same := func(a, b reflect.Value) bool {
+			switch a.Kind() {
+			case reflect.Func, reflect.Pointer, reflect.Map, reflect.Chan, reflect.Slice:
+				return a.Pointer() == b.Pointer()
+			default:
+				return a.Comparable() && a.Equal(b)
+			}
+		}
+
+		clone := client.WithToken("guest-token")
+		original := reflect.ValueOf(client).Elem()
+		copied := reflect.ValueOf(clone).Elem()
+		for i := range original.NumField() {
+			name := original.Type().Field(i).Name
+			if notCopied[name] {
+				continue
+			}
+			if original.Field(i).IsZero() {
+				t.Errorf("%s: set the field in this test so that its copy can be checked", name)
+				continue
+			}
+			if !same(original.Field(i), copied.Field(i)) {
+				t.Errorf("%s: expected the field to be copied as is", name)
+			}
+		}
+		if clone.ownsHTTPClient {
+			t.Error("expected the clone not to own the HTTP client")
+		}
+	})
+
+	t.Run("error case: an empty token returns ErrNoToken", func(t *testing.T) {
+		t.Parallel()
+
+		baseURL, err := url.Parse("https://test.getmoneytree.com/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+		setTestToken(client, "shared-token")
+
+		_, err = client.WithToken("").GetProfile(context.Background())
+		if !errors.Is(err, ErrNoToken) {
+			t.Errorf("expected ErrNoToken, got %v", err)
+		}
+	})
+}
+
+func TestMaskToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		token    string
+		expected string
+	}{
+		{name: "success case: a long token keeps its first and last 4 characters", token: "test-access-token", expected: "test*********oken"},
+		{name: "success case: a 9-character token", token: "123456789", expected: "1234*6789"},
+		{name: "success case: an 8-character token is masked entirely", token: "12345678", expected: "********"},
+		{name: "success case: an empty token", token: "", expected: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := MaskToken(tt.token); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestTokenAbsentFromErrors(t *testing.T) {
+	t.Parallel()
+
+	const accessToken = "secret-access-token-123"
+
+	t.Run("error case: an APIError does not contain the token", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.RawQuery != "" {
+				t.Errorf("expected no query parameters, got %s", r.URL.RawQuery)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error": "invalid_token", "error_description": "access token is invalid or expired"}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+		setTestToken(client, accessToken)
+
+		_, err = client.GetPersonalAccounts(context.Background())
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if strings.Contains(err.Error(), accessToken) {
+			t.Errorf("expected the token to be absent, got %q", err.Error())
+		}
+	})
+
+	t.Run("error case: a network error does not contain the token", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+		server.Close()
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		_, err = client.GetProfile(WithCallOptions(context.Background(), WithAccessToken(accessToken)))
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if strings.Contains(err.Error(), accessToken) {
+			t.Errorf("expected the token to be absent, got %q", err.Error())
+		}
+	})
+}
@@ -0,0 +1,336 @@
+package moneytree
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// maxPage is the largest page number accepted by the Moneytree LINK API.
+	maxPage = 100000
+	// maxPerPage is the largest per_page value accepted by the Moneytree LINK API.
+	maxPerPage = 500
+)
+
+// WithPaginationTimeout bounds the time the helpers that fetch every page of a list, such as
+// AllAccounts, GetTransactionsByDateRange and NetWorth, may spend on each list.
+// The timeout is a single deadline spanning all the pages of the list, so that a long list cannot
+// keep a helper running indefinitely under a context without a deadline. When it expires, the
+// helper returns an error that matches context.DeadlineExceeded with errors.Is.
+//
+// This is distinct from the timeout of the HTTP client, which applies to each request.
+// Zero or a negative value, the default, disables the timeout.
+//
+// Example:
+//
+//	client, err := moneytree.NewClient("jp-api-staging",
+//		moneytree.WithPaginationTimeout(5*time.Minute),
+//	)
+func WithPaginationTimeout(timeout time.Duration) NewClientOption {
+	return func(c *Client) {
+		c.paginationTimeout = timeout
+	}
+}
+
+// pageInfo holds the pagination metadata advertised by the headers of a response.
+type pageInfo struct {
+	// present reports whether the response had a Link header (RFC 5988) at all.
+	present bool
+	// next is the URL of the next page resolved against the BaseURL, or empty if there is none.
+	next string
+	// perPage is the page size the server applied, taken from the X-Per-Page header, or 0 if unknown.
+	perPage int
+}
+
+// pageFetcher retrieves a single page of a list endpoint.
+// urlPath is the request path relative to the BaseURL, including the query string,
+// or an absolute URL taken from a Link header.
+type pageFetcher[T any] func(ctx context.Context, urlPath string) ([]T, pageInfo, error)
+
+// listPageFetcher returns a pageFetcher that issues GET requests and extracts
+// the items from the decoded response of type R with items.
+func listPageFetcher[R, T any](c *Client, items func(*R) []T) pageFetcher[T] {
+	return func(ctx context.Context, urlPath string) ([]T, pageInfo, error) {
+		httpReq, err := c.NewRequest(ctx, http.MethodGet, urlPath, nil)
+		if err != nil {
+			return nil, pageInfo{}, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var res R
+		resp, err := c.Do(ctx, httpReq, &res)
+		if err != nil {
+			return nil, pageInfo{}, err
+		}
+
+		info, err := c.parsePageInfo(resp.Header)
+		if err != nil {
+			return nil, pageInfo{}, err
+		}
+		return items(&res), info, nil
+	}
+}
+
+// parsePageInfo extracts the next page URL from the Link headers and the applied page size
+// from the X-Per-Page header of a response.
+// Relative URLs are resolved against the BaseURL. A next URL pointing to another host
+// is rejected, because following it would send the access token to that host.
+func (c *Client) parsePageInfo(header http.Header) (pageInfo, error) {
+	var info pageInfo
+	if perPage, err := strconv.Atoi(header.Get("X-Per-Page")); err == nil && perPage > 0 {
+		info.perPage = perPage
+	}
+
+	values := header.Values("Link")
+	if len(values) == 0 {
+		return info, nil
+	}
+
+	info.present = true
+	next := findLinkByRel(values, "next")
+	if next == "" {
+		return info, nil
+	}
+
+	u, err := c.config.BaseURL.Parse(next)
+	if err != nil {
+		return pageInfo{}, fmt.Errorf("failed to parse next page link %q: %w", next, err)
+	}
+	if u.Scheme != c.config.BaseURL.Scheme || u.Host != c.config.BaseURL.Host {
+		return pageInfo{}, fmt.Errorf("next page link must point to %s, got %s", c.config.BaseURL.Host, u.Host)
+	}
+	info.next = u.String()
+	return info, nil
+}
+
+// findLinkByRel returns the target of the first link with the given relation type
+// in Link header values such as `<https://example.com/?page=2>; rel="next"`.
+func findLinkByRel(values []string, rel string) string {
+	for _, value := range values {
+		for _, link := range strings.Split(value, ",") {
+			parts := strings.Split(link, ";")
+			target := strings.TrimSpace(parts[0])
+			if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+			for _, param := range parts[1:] {
+				key, val, ok := strings.Cut(strings.TrimSpace(param), "=")
+				if !ok || !strings.EqualFold(strings.TrimSpace(key), "rel") {
+					continue
+				}
+				// A rel parameter may hold several space-separated relation types.
+				for _, r := range strings.Fields(strings.Trim(strings.TrimSpace(val), `"`)) {
+					if strings.EqualFold(r, rel) {
+						return strings.TrimSuffix(strings.TrimPrefix(target, "<"), ">")
+					}
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// Pager retrieves the pages of a list endpoint one at a time, for callers that want explicit
+// control over when each page is requested. It is created by methods such as
+// Client.PersonalAccountTransactionsPager and follows the same pagination rules as the helpers
+// that fetch every page: Link headers are followed when present, and otherwise a page shorter
+// than the X-Per-Page header, or than the requested page size without it, is the last one.
+//
+// A Pager is not safe for concurrent use.
+//
+// Example:
+//
+//	pager := client.PersonalAccountTransactionsPager("account_key_123")
+//	for pager.HasMore() {
+//		transactions, err := pager.Next(ctx)
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		for _, transaction := range transactions {
+//			fmt.Printf("ID: %d, Amount: %v\n", transaction.ID, transaction.Amount)
+//		}
+//	}
+type Pager[T any] struct {
+	fetch       pageFetcher[T]
+	urlPath     string
+	queryParams url.Values
+	perPage     int
+	// filter, if set, is applied to the items of each page after the page size has been checked.
+	filter func([]T) []T
+	// err is returned by the next call to Next, e.g. because the options are invalid.
+	err error
+
+	page     int
+	nextURL  string
+	pageSize int
+	done     bool
+}
+
+// newPager returns a Pager that starts at page firstPage of urlPath.
+// queryParams holds additional query parameters sent with every numbered page; it is not modified.
+func newPager[T any](urlPath string, queryParams url.Values, firstPage, perPage int, fetch pageFetcher[T]) *Pager[T] {
+	p := &Pager[T]{
+		fetch:       fetch,
+		urlPath:     urlPath,
+		queryParams: queryParams,
+		perPage:     perPage,
+		page:        firstPage,
+		pageSize:    perPage,
+	}
+	if perPage < 1 || perPage > maxPerPage {
+		p.err = fmt.Errorf("per_page must be between 1 and %d, got: %d", maxPerPage, perPage)
+	}
+	return p
+}
+
+// failedPager returns a Pager whose first call to Next returns err.
+func failedPager[T any](err error) *Pager[T] {
+	return &Pager[T]{err: err}
+}
+
+// HasMore reports whether Next may return more items. It is true until the last page
+// has been retrieved or Next has returned an error that cannot be recovered from.
+// After a failed request, HasMore stays true so that Next can retry the same page.
+func (p *Pager[T]) HasMore() bool {
+	return !p.done
+}
+
+// Next retrieves the next page and returns its items. Once HasMore is false,
+// Next returns nil and a nil error without sending a request.
+// If the request fails, the error is returned and the next call retries the same page.
+func (p *Pager[T]) Next(ctx context.Context) ([]T, error) {
+	if p.done {
+		return nil, nil
+	}
+	if p.err != nil {
+		p.done = true
+		return nil, p.err
+	}
+	if p.page > maxPage {
+		p.done = true
+		return nil, fmt.Errorf("pagination exceeded the maximum page number %d", maxPage)
+	}
+
+	requestURL := p.nextURL
+	if requestURL == "" {
+		query := url.Values{}
+		for key, values := range p.queryParams {
+			query[key] = values
+		}
+		query.Set("page", fmt.Sprintf("%d", p.page))
+		query.Set("per_page", fmt.Sprintf("%d", p.perPage))
+		requestURL = fmt.Sprintf("%s?%s", p.urlPath, query.Encode())
+	}
+
+	items, info, err := p.fetch(ctx, requestURL)
+	if err != nil {
+		return nil, fmt.Errorf("page %d: %w", p.page, err)
+	}
+	p.page++
+
+	if info.present {
+		p.nextURL = info.next
+		p.done = info.next == ""
+	} else {
+		if info.perPage > 0 {
+			p.pageSize = info.perPage
+		} else if len(items) > p.pageSize {
+			p.pageSize = len(items)
+		}
+
+		// Without a Link header, a short page means there are no more items to fetch.
+		// A server that lowers per_page without an X-Per-Page header is indistinguishable from a last page.
+		p.nextURL = ""
+		p.done = len(items) < p.pageSize
+	}
+
+	if p.filter != nil {
+		items = p.filter(items)
+	}
+	return items, nil
+}
+
+// PaginationError is returned by the helpers that fetch every page of a list, such as
+// GetTransactionsByDateRange and AllAccounts, when a page cannot be retrieved. It carries the
+// items of the pages retrieved before the failure, so that a long run that fails near the end does
+// not have to start over: the remaining pages can be requested from page LastSuccessfulPage+1,
+// e.g. with PersonalAccountTransactionsPager and WithPageForTransactions.
+// The helpers wrap it with context, so use errors.As to retrieve it.
+//
+// Example:
+//
+//	_, err := client.GetTransactionsByDateRange(ctx, start, end)
+//	var paginationErr *moneytree.PaginationError
+//	if errors.As(err, &paginationErr) {
+//		transactions, _ := paginationErr.PartialResults.([]moneytree.PersonalAccountTransaction)
+//		fmt.Printf("%d transactions retrieved before page %d failed\n",
+//			len(transactions), paginationErr.LastSuccessfulPage+1)
+//	}
+type PaginationError struct {
+	// Path is the request path of the list relative to the BaseURL, e.g. "link/accounts.json".
+	Path string
+	// LastSuccessfulPage is the number of the last page retrieved, or 0 if the first page failed.
+	LastSuccessfulPage int
+	// PartialResults holds the items of the pages retrieved before the failure as a slice of the
+	// list's item type, e.g. []PersonalAccountTransaction, before any filtering by the helper.
+	// It is nil if the first page failed.
+	PartialResults any
+	// Err is the error of the failed page.
+	Err error
+}
+
+// Error returns the error of the failed page, which names the page number.
+func (e *PaginationError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the error of the failed page, so that errors.Is and errors.As see through a PaginationError.
+func (e *PaginationError) Unwrap() error {
+	return e.Err
+}
+
+// fetchAllPages requests the pages of urlPath starting from page 1 and returns the items of all pages in order.
+// queryParams holds additional query parameters sent with the first page; it is not modified.
+// perPage must be between 1 and maxPerPage: a larger value would be clamped by the server.
+// If timeout is positive, it bounds the whole fetch rather than each page (see WithPaginationTimeout).
+//
+// When a response has a Link header, its rel="next" URL is followed and pagination stops
+// once a response no longer advertises a next page. Otherwise, the page number is incremented
+// until a page returns fewer items than the page size.
+// If a page fails, the error is a *PaginationError holding the items of the previous pages.
+//
+// The page size used for that check is the X-Per-Page header if present, or the size of the largest
+// page seen if the server returned more items than requested. A server that lowers per_page is only
+// detected through X-Per-Page or a Link header: without them, its full pages are shorter than the
+// requested size, so pagination stops after the first page. Request a per_page the server accepts
+// (at most maxPerPage) to avoid this.
+func fetchAllPages[T any](ctx context.Context, timeout time.Duration, urlPath string, queryParams url.Values, perPage int, fetch pageFetcher[T]) ([]T, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	pager := newPager(urlPath, queryParams, 1, perPage, fetch)
+	var all []T
+	for pager.HasMore() {
+		items, err := pager.Next(ctx)
+		if err != nil {
+			if pager.err != nil {
+				// The arguments are invalid, so no page was requested.
+				return nil, err
+			}
+			paginationErr := &PaginationError{Path: urlPath, LastSuccessfulPage: pager.page - 1, Err: err}
+			if all != nil {
+				paginationErr.PartialResults = all
+			}
+			return nil, paginationErr
+		}
+		all = append(all, items...)
+	}
+	return all, nil
+}
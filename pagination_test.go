@@ -0,0 +1,565 @@
+package moneytree
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFetchAllPages(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: stops at the first short page", func(t *testing.T) {
+		t.Parallel()
+
+		pages := map[string][]int{
+			"link/items.json?page=1&per_page=2&since=2023-01-01": {1, 2},
+			"link/items.json?page=2&per_page=2&since=2023-01-01": {3, 4},
+			"link/items.json?page=3&per_page=2&since=2023-01-01": {5},
+		}
+		var requested []string
+		fetch := func(ctx context.Context, urlPath string) ([]int, pageInfo, error) {
+			requested = append(requested, urlPath)
+			items, ok := pages[urlPath]
+			if !ok {
+				t.Errorf("unexpected urlPath %s", urlPath)
+			}
+			return items, pageInfo{}, nil
+		}
+
+		queryParams := url.Values{}
+		queryParams.Set("since", "2023-01-01")
+		items, err := fetchAllPages(context.Background(), 0, "link/items.json", queryParams, 2, fetch)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+
+		expected := []int{1, 2, 3, 4, 5}
+		if len(items) != len(expected) {
+			t.Fatalf("expected %d items, got %d", len(expected), len(items))
+		}
+		for i := range expected {
+			if items[i] != expected[i] {
+				t.Errorf("expected items[%d] %d, got %d", i, expected[i], items[i])
+			}
+		}
+		if len(requested) != 3 {
+			t.Errorf("expected 3 requests, got %d", len(requested))
+		}
+		if queryParams.Get("page") != "" {
+			t.Error("expected queryParams not to be modified")
+		}
+	})
+
+	t.Run("success case: an empty first page returns no items", func(t *testing.T) {
+		t.Parallel()
+
+		fetch := func(ctx context.Context, urlPath string) ([]int, pageInfo, error) {
+			return nil, pageInfo{}, nil
+		}
+
+		items, err := fetchAllPages(context.Background(), 0, "link/items.json", url.Values{}, 2, fetch)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if len(items) != 0 {
+			t.Errorf("expected 0 items, got %d", len(items))
+		}
+	})
+
+	t.Run("success case: keeps paginating when the server clamps per_page and reports X-Per-Page", func(t *testing.T) {
+		t.Parallel()
+
+		var requested []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requested = append(requested, r.URL.RequestURI())
+			// The server clamps the requested per_page=500 to 2 items per page.
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("X-Per-Page", "2")
+			switch r.URL.Query().Get("page") {
+			case "1":
+				_, _ = w.Write([]byte(`{"accounts": [{"account_key": "a"}, {"account_key": "b"}]}`))
+			case "2":
+				_, _ = w.Write([]byte(`{"accounts": [{"account_key": "c"}, {"account_key": "d"}]}`))
+			case "3":
+				_, _ = w.Write([]byte(`{"accounts": [{"account_key": "e"}]}`))
+			default:
+				t.Errorf("unexpected request %s", r.URL.RequestURI())
+			}
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		setTestToken(client, "test-access-token")
+		accounts, err := fetchAllPages(context.Background(), 0, "link/accounts.json", url.Values{}, 500,
+			listPageFetcher(client, func(res *PersonalAccounts) []PersonalAccount { return res.Accounts }))
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if len(accounts) != 5 {
+			t.Errorf("expected 5 accounts, got %d", len(accounts))
+		}
+		if len(requested) != 3 {
+			t.Errorf("expected 3 requests, got %v", requested)
+		}
+	})
+
+	t.Run("success case: adapts when the server returns more items than requested", func(t *testing.T) {
+		t.Parallel()
+
+		pages := map[string][]int{
+			"link/items.json?page=1&per_page=2": {1, 2, 3},
+			"link/items.json?page=2&per_page=2": {4, 5, 6},
+			"link/items.json?page=3&per_page=2": {7, 8},
+		}
+		fetch := func(ctx context.Context, urlPath string) ([]int, pageInfo, error) {
+			items, ok := pages[urlPath]
+			if !ok {
+				t.Errorf("unexpected urlPath %s", urlPath)
+			}
+			return items, pageInfo{}, nil
+		}
+
+		items, err := fetchAllPages(context.Background(), 0, "link/items.json", url.Values{}, 2, fetch)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if len(items) != 8 {
+			t.Errorf("expected 8 items, got %d", len(items))
+		}
+	})
+
+	t.Run("error case: returns error when per_page exceeds the maximum", func(t *testing.T) {
+		t.Parallel()
+
+		fetch := func(ctx context.Context, urlPath string) ([]int, pageInfo, error) {
+			t.Errorf("unexpected request %s", urlPath)
+			return nil, pageInfo{}, nil
+		}
+
+		if _, err := fetchAllPages(context.Background(), 0, "link/items.json", url.Values{}, maxPerPage+1, fetch); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+
+	t.Run("error case: returns the error of the failing page", func(t *testing.T) {
+		t.Parallel()
+
+		fetchErr := errors.New("fetch failed")
+		fetch := func(ctx context.Context, urlPath string) ([]int, pageInfo, error) {
+			if urlPath == "link/items.json?page=2&per_page=1" {
+				return nil, pageInfo{}, fetchErr
+			}
+			return []int{1}, pageInfo{}, nil
+		}
+
+		_, err := fetchAllPages(context.Background(), 0, "link/items.json", url.Values{}, 1, fetch)
+		if !errors.Is(err, fetchErr) {
+			t.Errorf("expected fetch error, got %v", err)
+		}
+	})
+}
+
+func TestPaginationError(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: a run failing on page 3 is resumed from that page", func(t *testing.T) {
+		t.Parallel()
+
+		pages := map[string][]int{
+			"link/items.json?page=1&per_page=2": {1, 2},
+			"link/items.json?page=2&per_page=2": {3, 4},
+			"link/items.json?page=3&per_page=2": {5, 6},
+			"link/items.json?page=4&per_page=2": {7},
+		}
+		fetchErr := errors.New("fetch failed")
+		failed := false
+		var requested []string
+		fetch := func(ctx context.Context, urlPath string) ([]int, pageInfo, error) {
+			requested = append(requested, urlPath)
+			if urlPath == "link/items.json?page=3&per_page=2" && !failed {
+				failed = true
+				return nil, pageInfo{}, fetchErr
+			}
+			return pages[urlPath], pageInfo{}, nil
+		}
+
+		_, err := fetchAllPages(context.Background(), 0, "link/items.json", url.Values{}, 2, fetch)
+		var paginationErr *PaginationError
+		if !errors.As(err, &paginationErr) {
+			t.Fatalf("expected *PaginationError, got %v", err)
+		}
+		if !errors.Is(err, fetchErr) {
+			t.Errorf("expected fetch error, got %v", err)
+		}
+		if paginationErr.Path != "link/items.json" {
+			t.Errorf("expected path link/items.json, got %s", paginationErr.Path)
+		}
+		if paginationErr.LastSuccessfulPage != 2 {
+			t.Fatalf("expected last successful page 2, got %d", paginationErr.LastSuccessfulPage)
+		}
+		partial, ok := paginationErr.PartialResults.([]int)
+		if !ok || !slices.Equal(partial, []int{1, 2, 3, 4}) {
+			t.Fatalf("expected partial results [1 2 3 4], got %v", paginationErr.PartialResults)
+		}
+
+		requested = nil
+		pager := newPager("link/items.json", url.Values{}, paginationErr.LastSuccessfulPage+1, 2, fetch)
+		all := partial
+		for pager.HasMore() {
+			items, err := pager.Next(context.Background())
+			if err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+			all = append(all, items...)
+		}
+		if !slices.Equal(all, []int{1, 2, 3, 4, 5, 6, 7}) {
+			t.Errorf("expected [1 2 3 4 5 6 7], got %v", all)
+		}
+		if len(requested) != 2 {
+			t.Errorf("expected only pages 3 and 4 to be requested, got %v", requested)
+		}
+	})
+
+	t.Run("success case: a failing first page has no partial results", func(t *testing.T) {
+		t.Parallel()
+
+		fetch := func(ctx context.Context, urlPath string) ([]int, pageInfo, error) {
+			return nil, pageInfo{}, errors.New("fetch failed")
+		}
+
+		_, err := fetchAllPages(context.Background(), 0, "link/items.json", url.Values{}, 2, fetch)
+		var paginationErr *PaginationError
+		if !errors.As(err, &paginationErr) {
+			t.Fatalf("expected *PaginationError, got %v", err)
+		}
+		if paginationErr.LastSuccessfulPage != 0 || paginationErr.PartialResults != nil {
+			t.Errorf("expected page 0 and no partial results, got %d and %v",
+				paginationErr.LastSuccessfulPage, paginationErr.PartialResults)
+		}
+	})
+
+	t.Run("error case: invalid arguments are not a PaginationError", func(t *testing.T) {
+		t.Parallel()
+
+		fetch := func(ctx context.Context, urlPath string) ([]int, pageInfo, error) {
+			return nil, pageInfo{}, nil
+		}
+
+		_, err := fetchAllPages(context.Background(), 0, "link/items.json", url.Values{}, maxPerPage+1, fetch)
+		var paginationErr *PaginationError
+		if err == nil || errors.As(err, &paginationErr) {
+			t.Errorf("expected a plain error, got %v", err)
+		}
+	})
+}
+
+func TestFetchAllPages_LinkHeader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: follows rel=next links until the header omits them", func(t *testing.T) {
+		t.Parallel()
+
+		var requested []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requested = append(requested, r.URL.RequestURI())
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Query().Get("cursor") {
+			case "":
+				// Absolute next link. Pages are shorter than per_page on purpose:
+				// the Link header takes precedence over the short page heuristic.
+				w.Header().Set("Link", fmt.Sprintf(`<http://%s/link/accounts.json?cursor=b>; rel="next", <http://%s/link/accounts.json?cursor=z>; rel="last"`, r.Host, r.Host))
+				_, _ = w.Write([]byte(`{"accounts": [{"account_key": "a"}]}`))
+			case "b":
+				// Relative next link resolved against the BaseURL.
+				w.Header().Set("Link", `</link/accounts.json?cursor=c>; rel="next"`)
+				_, _ = w.Write([]byte(`{"accounts": [{"account_key": "b"}]}`))
+			case "c":
+				w.Header().Set("Link", `</link/accounts.json?cursor=a>; rel="first"`)
+				_, _ = w.Write([]byte(`{"accounts": [{"account_key": "c"}]}`))
+			default:
+				t.Errorf("unexpected request %s", r.URL.RequestURI())
+			}
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		setTestToken(client, "test-access-token")
+		accounts, err := fetchAllPages(context.Background(), 0, "link/accounts.json", url.Values{}, 500,
+			listPageFetcher(client, func(res *PersonalAccounts) []PersonalAccount { return res.Accounts }))
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+
+		if len(accounts) != 3 {
+			t.Fatalf("expected 3 accounts, got %d", len(accounts))
+		}
+		for i, expected := range []string{"a", "b", "c"} {
+			if accounts[i].AccountKey != expected {
+				t.Errorf("expected accounts[%d].AccountKey %s, got %s", i, expected, accounts[i].AccountKey)
+			}
+		}
+		expectedRequests := []string{
+			"/link/accounts.json?page=1&per_page=500",
+			"/link/accounts.json?cursor=b",
+			"/link/accounts.json?cursor=c",
+		}
+		if len(requested) != len(expectedRequests) {
+			t.Fatalf("expected %d requests, got %v", len(expectedRequests), requested)
+		}
+		for i := range expectedRequests {
+			if requested[i] != expectedRequests[i] {
+				t.Errorf("expected request[%d] %s, got %s", i, expectedRequests[i], requested[i])
+			}
+		}
+	})
+
+	t.Run("error case: rejects a next link pointing to another host", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("Link", `<https://evil.example.com/link/accounts.json?page=2>; rel="next"`)
+			_, _ = w.Write([]byte(`{"accounts": [{"account_key": "a"}]}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		setTestToken(client, "test-access-token")
+		_, err = fetchAllPages(context.Background(), 0, "link/accounts.json", url.Values{}, 500,
+			listPageFetcher(client, func(res *PersonalAccounts) []PersonalAccount { return res.Accounts }))
+		if err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
+
+func TestFindLinkByRel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		values []string
+		want   string
+	}{
+		{
+			name:   "next link among several links",
+			values: []string{`<https://a.example.com/?page=1>; rel="prev", <https://a.example.com/?page=3>; rel="next"`},
+			want:   "https://a.example.com/?page=3",
+		},
+		{
+			name:   "unquoted rel and multiple Link headers",
+			values: []string{`</first>; rel=first`, `</next>; rel=next`},
+			want:   "/next",
+		},
+		{
+			name:   "space-separated relation types",
+			values: []string{`</next>; title="x"; rel="last next"`},
+			want:   "/next",
+		},
+		{
+			name:   "no next link",
+			values: []string{`</last>; rel="last"`},
+			want:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := findLinkByRel(tt.values, "next"); got != tt.want {
+				t.Errorf("findLinkByRel(%v) returned %q, want %q", tt.values, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPager(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: advances through three pages and HasMore flips to false", func(t *testing.T) {
+		t.Parallel()
+
+		pages := map[string][]int{
+			"link/items.json?page=1&per_page=2": {1, 2},
+			"link/items.json?page=2&per_page=2": {3, 4},
+			"link/items.json?page=3&per_page=2": {5},
+		}
+		requests := 0
+		fetch := func(ctx context.Context, urlPath string) ([]int, pageInfo, error) {
+			requests++
+			items, ok := pages[urlPath]
+			if !ok {
+				t.Errorf("unexpected urlPath %s", urlPath)
+			}
+			return items, pageInfo{}, nil
+		}
+
+		pager := newPager("link/items.json", url.Values{}, 1, 2, fetch)
+		expected := [][]int{{1, 2}, {3, 4}, {5}}
+		for i, want := range expected {
+			if !pager.HasMore() {
+				t.Fatalf("expected HasMore to be true before page %d", i+1)
+			}
+			items, err := pager.Next(context.Background())
+			if err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+			if fmt.Sprint(items) != fmt.Sprint(want) {
+				t.Errorf("page %d: expected %v, got %v", i+1, want, items)
+			}
+		}
+		if pager.HasMore() {
+			t.Error("expected HasMore to be false after the last page")
+		}
+
+		items, err := pager.Next(context.Background())
+		if err != nil || items != nil {
+			t.Errorf("expected nil items and nil error after the last page, got %v, %v", items, err)
+		}
+		if requests != 3 {
+			t.Errorf("expected 3 requests, got %d", requests)
+		}
+	})
+
+	t.Run("success case: a failed page is retried by the next call", func(t *testing.T) {
+		t.Parallel()
+
+		errTemporary := errors.New("temporary failure")
+		var requested []string
+		fetch := func(ctx context.Context, urlPath string) ([]int, pageInfo, error) {
+			requested = append(requested, urlPath)
+			if len(requested) == 1 {
+				return nil, pageInfo{}, errTemporary
+			}
+			return []int{1}, pageInfo{}, nil
+		}
+
+		pager := newPager("link/items.json", url.Values{}, 1, 2, fetch)
+		if _, err := pager.Next(context.Background()); !errors.Is(err, errTemporary) {
+			t.Fatalf("expected errTemporary, got %v", err)
+		}
+		if !pager.HasMore() {
+			t.Fatal("expected HasMore to stay true after a failed request")
+		}
+		items, err := pager.Next(context.Background())
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if len(items) != 1 || pager.HasMore() {
+			t.Errorf("expected the single item of the last page, got %v (HasMore %v)", items, pager.HasMore())
+		}
+		if requested[0] != requested[1] {
+			t.Errorf("expected the same page to be requested again, got %v", requested)
+		}
+	})
+
+	t.Run("error case: invalid per_page is reported by Next", func(t *testing.T) {
+		t.Parallel()
+
+		fetch := func(ctx context.Context, urlPath string) ([]int, pageInfo, error) {
+			t.Error("expected no request")
+			return nil, pageInfo{}, nil
+		}
+
+		pager := newPager("link/items.json", url.Values{}, 1, 0, fetch)
+		if _, err := pager.Next(context.Background()); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if pager.HasMore() {
+			t.Error("expected HasMore to be false after an invalid option")
+		}
+	})
+}
+
+func TestWithPaginationTimeout(t *testing.T) {
+	t.Parallel()
+
+	t.Run("error case: the timeout spans all pages and aborts the loop", func(t *testing.T) {
+		t.Parallel()
+
+		var pages atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			page := pages.Add(1)
+			// Each page is fast, but there is always a next one.
+			time.Sleep(20 * time.Millisecond)
+			w.Header().Set("Link", fmt.Sprintf(`</link/accounts.json?cursor=%d>; rel="next"`, page))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"accounts": [{"account_key": "account"}]}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client, err := NewClient("jp-api-staging", WithPaginationTimeout(200*time.Millisecond))
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		client.config.BaseURL = baseURL
+		setTestToken(client, "test-access-token")
+
+		_, err = client.NetWorth(context.Background())
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+		}
+		if got := pages.Load(); got < 2 || got > 20 {
+			t.Errorf("expected the loop to stop after a few pages, got %d pages", got)
+		}
+	})
+
+	t.Run("success case: no timeout by default", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewClient("jp-api-staging")
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if client.paginationTimeout != 0 {
+			t.Errorf("expected no pagination timeout, got %v", client.paginationTimeout)
+		}
+	})
+}
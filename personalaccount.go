@@ -2,9 +2,16 @@ package moneytree
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 )
 
 // paginationOptions represents common pagination options used across multiple API endpoints.
@@ -13,6 +20,22 @@ type paginationOptions struct {
 	PerPage *int
 }
 
+// applyPagination applies the pagination options to the query parameters, using Config.DefaultPerPage
+// when no per_page option is given, and validates them unless client validation is skipped.
+func (c *Client) applyPagination(queryParams url.Values, opts *paginationOptions) error {
+	if opts.PerPage == nil && c.config != nil && c.config.DefaultPerPage > 0 {
+		perPage := c.config.DefaultPerPage
+		opts.PerPage = &perPage
+	}
+	if !c.skipClientValidation() {
+		if err := validatePagination(opts); err != nil {
+			return err
+		}
+	}
+	applyPaginationParams(queryParams, opts)
+	return nil
+}
+
 // applyPaginationParams applies pagination parameters to the query parameters.
 func applyPaginationParams(queryParams url.Values, opts *paginationOptions) {
 	if opts.Page != nil {
@@ -117,7 +140,9 @@ func (c *Client) GetPersonalAccounts(ctx context.Context, opts ...GetPersonalAcc
 
 	urlPath := "link/accounts.json"
 	queryParams := url.Values{}
-	applyPaginationParams(queryParams, &options.paginationOptions)
+	if err := c.applyPagination(queryParams, &options.paginationOptions); err != nil {
+		return nil, err
+	}
 	if len(queryParams) > 0 {
 		urlPath = fmt.Sprintf("%s?%s", urlPath, queryParams.Encode())
 	}
@@ -134,6 +159,42 @@ func (c *Client) GetPersonalAccounts(ctx context.Context, opts ...GetPersonalAcc
 	return &res, nil
 }
 
+// GetPersonalAccount retrieves a single personal account by its account key,
+// e.g. to refresh the metadata of one account without listing them all.
+// This endpoint requires the accounts_read OAuth scope.
+//
+// If the account does not exist, the returned error is an *APIError with StatusCode 404,
+// which also matches ErrNotFound with errors.Is.
+//
+// Example:
+//
+//	account, err := client.GetPersonalAccount(ctx, "account_key_123")
+//	if errors.Is(err, moneytree.ErrNotFound) {
+//		// The account does not exist or was deleted.
+//	}
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Account: %s, Type: %s, Balance: %v\n", account.AccountKey, account.AccountType, account.Balance)
+func (c *Client) GetPersonalAccount(ctx context.Context, accountKey string) (*PersonalAccount, error) {
+	if accountKey == "" {
+		return nil, fmt.Errorf("account key is required")
+	}
+
+	urlPath := fmt.Sprintf("link/accounts/%s.json", escapePathSegment(accountKey))
+
+	httpReq, err := c.NewRequest(ctx, http.MethodGet, urlPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var res PersonalAccount
+	if _, err = c.Do(ctx, httpReq, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
 // PersonalAccountBalance represents a balance record for a personal account returned by the Moneytree LINK API.
 type PersonalAccountBalance struct {
 	// ID is the balance record ID.
@@ -144,7 +205,9 @@ type PersonalAccountBalance struct {
 	// Format: "2006-01-02" (YYYY-MM-DD).
 	Date string `json:"date"`
 	// Balance is the account balance.
-	Balance float64 `json:"balance"`
+	// This value is nil if the API returns null, so that a missing balance
+	// can be distinguished from an actual zero balance.
+	Balance *float64 `json:"balance"`
 	// BalanceInBase is the account balance converted to JPY.
 	// If the financial service provides the converted amount for foreign currency,
 	// that amount is stored and returned in this field. If not supported,
@@ -163,7 +226,9 @@ type GetPersonalAccountBalancesOption func(*getPersonalAccountBalancesOptions)
 
 type getPersonalAccountBalancesOptions struct {
 	paginationOptions
-	Since *string
+	Since     *string
+	StartDate *string
+	EndDate   *string
 }
 
 // WithPageForBalances specifies the page number for pagination.
@@ -193,6 +258,24 @@ func WithSinceForBalances(since string) GetPersonalAccountBalancesOption {
 	}
 }
 
+// WithStartDateForBalances specifies the first date of the balance records to retrieve (start_date).
+// If specified, end_date is also required, and start_date must be on or before end_date.
+// Date format: "2006-01-02" (YYYY-MM-DD).
+func WithStartDateForBalances(startDate string) GetPersonalAccountBalancesOption {
+	return func(opts *getPersonalAccountBalancesOptions) {
+		opts.StartDate = &startDate
+	}
+}
+
+// WithEndDateForBalances specifies the last date of the balance records to retrieve (end_date).
+// If specified, start_date is also required.
+// Date format: "2006-01-02" (YYYY-MM-DD).
+func WithEndDateForBalances(endDate string) GetPersonalAccountBalancesOption {
+	return func(opts *getPersonalAccountBalancesOptions) {
+		opts.EndDate = &endDate
+	}
+}
+
 // GetPersonalAccountBalances retrieves the balance history for a specific personal account.
 // This endpoint requires the accounts_read OAuth scope.
 //
@@ -210,7 +293,10 @@ func WithSinceForBalances(since string) GetPersonalAccountBalancesOption {
 //		log.Fatal(err)
 //	}
 //	for _, balance := range response.AccountBalances {
-//		fmt.Printf("Date: %s, Balance: %v, BalanceInBase: %v\n", balance.Date, balance.Balance, balance.BalanceInBase)
+//		if balance.Balance == nil {
+//			continue // balance could not be retrieved
+//		}
+//		fmt.Printf("Date: %s, Balance: %v, BalanceInBase: %v\n", balance.Date, *balance.Balance, balance.BalanceInBase)
 //	}
 //
 // Example with since parameter:
@@ -219,6 +305,13 @@ func WithSinceForBalances(since string) GetPersonalAccountBalancesOption {
 //		moneytree.WithSinceForBalances("2023-01-01"),
 //	)
 //
+// Example with a date window:
+//
+//	response, err := client.GetPersonalAccountBalances(ctx, accessToken, "account_key_123",
+//		moneytree.WithStartDateForBalances("2023-01-01"),
+//		moneytree.WithEndDateForBalances("2023-01-31"),
+//	)
+//
 // Reference: https://docs.link.getmoneytree.com/reference/get-link-account-balances
 func (c *Client) GetPersonalAccountBalances(ctx context.Context, accountID string, opts ...GetPersonalAccountBalancesOption) (*PersonalAccountBalances, error) {
 	if accountID == "" {
@@ -230,18 +323,36 @@ func (c *Client) GetPersonalAccountBalances(ctx context.Context, accountID strin
 		opt(options)
 	}
 
-	if options.Since != nil {
-		if err := validateDateFormat(*options.Since); err != nil {
+	if !c.skipClientValidation() {
+		if options.Since != nil {
+			if err := validateDateFormat(*options.Since); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := validateDateWindow(options.StartDate, options.EndDate); err != nil {
+			return nil, err
+		}
+
+		if err := c.validateSinceNotInFuture(options.Since); err != nil {
 			return nil, err
 		}
 	}
 
-	urlPath := fmt.Sprintf("link/accounts/%s/balances.json", url.PathEscape(accountID))
+	urlPath := fmt.Sprintf("link/accounts/%s/balances.json", escapePathSegment(accountID))
 	queryParams := url.Values{}
-	applyPaginationParams(queryParams, &options.paginationOptions)
+	if err := c.applyPagination(queryParams, &options.paginationOptions); err != nil {
+		return nil, err
+	}
 	if options.Since != nil {
 		queryParams.Set("since", *options.Since)
 	}
+	if options.StartDate != nil {
+		queryParams.Set("start_date", *options.StartDate)
+	}
+	if options.EndDate != nil {
+		queryParams.Set("end_date", *options.EndDate)
+	}
 	if len(queryParams) > 0 {
 		urlPath = fmt.Sprintf("%s?%s", urlPath, queryParams.Encode())
 	}
@@ -258,6 +369,78 @@ func (c *Client) GetPersonalAccountBalances(ctx context.Context, accountID strin
 	return &res, nil
 }
 
+// GetBalancesForAccounts retrieves the balance history of several personal accounts concurrently,
+// e.g. to build a dashboard without requesting the accounts one after another.
+// This endpoint requires the accounts_read OAuth scope.
+//
+// Every page of each account's balance records is fetched, as GetPersonalAccountBalances would return them.
+// Up to WithConcurrency accounts are fetched at the same time, and WithPerPageForAggregates sets the page size.
+//
+// A failure for one account does not stop the others: the balances and the errors are returned in
+// separate maps keyed by account key. Both maps are always non-nil. Duplicate keys are fetched once.
+// If ctx is canceled, the requests in flight are canceled and the accounts that were not fetched
+// are reported with the context error. As errors are always collected per account,
+// WithErrorPolicy has no effect on this helper.
+//
+// Example:
+//
+//	balances, errs := client.GetBalancesForAccounts(ctx, accountKeys, moneytree.WithConcurrency(8))
+//	for accountKey, err := range errs {
+//		log.Printf("failed to get balances for account %s: %v", accountKey, err)
+//	}
+//	for accountKey, response := range balances {
+//		fmt.Printf("%s: %d balance records\n", accountKey, len(response.AccountBalances))
+//	}
+func (c *Client) GetBalancesForAccounts(ctx context.Context, accountKeys []string, opts ...AggregateOption) (map[string]*PersonalAccountBalances, map[string]error) {
+	options := newAggregateOptions(opts)
+
+	balances := make(map[string]*PersonalAccountBalances)
+	errs := make(map[string]error)
+
+	var unique []string
+	seen := make(map[string]bool)
+	for _, accountKey := range accountKeys {
+		if seen[accountKey] {
+			continue
+		}
+		seen[accountKey] = true
+		if accountKey == "" {
+			errs[accountKey] = fmt.Errorf("account ID is required")
+			continue
+		}
+		unique = append(unique, accountKey)
+	}
+
+	var mu sync.Mutex
+	err := runConcurrently(ctx, len(unique), options.Concurrency, func(ctx context.Context, i int) error {
+		accountKey := unique[i]
+		urlPath := fmt.Sprintf("link/accounts/%s/balances.json", escapePathSegment(accountKey))
+		records, err := fetchAllPages(ctx, c.paginationTimeout, urlPath, url.Values{}, options.perPage(),
+			listPageFetcher(c, func(res *PersonalAccountBalances) []PersonalAccountBalance { return res.AccountBalances }))
+
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			errs[accountKey] = fmt.Errorf("failed to get balances for account %s: %w", accountKey, err)
+		} else {
+			balances[accountKey] = &PersonalAccountBalances{AccountBalances: records}
+		}
+		// Errors are collected per account so that one failing account does not cancel the others.
+		return nil
+	})
+	if err != nil {
+		// The context was canceled before some accounts were fetched.
+		for _, accountKey := range unique {
+			if _, ok := balances[accountKey]; !ok {
+				if _, ok := errs[accountKey]; !ok {
+					errs[accountKey] = err
+				}
+			}
+		}
+	}
+	return balances, errs
+}
+
 // TermDeposit represents a term deposit record for a personal account returned by the Moneytree LINK API.
 type TermDeposit struct {
 	// ID is the balance record ID.
@@ -295,6 +478,81 @@ type TermDeposit struct {
 	TermLengthDay *int `json:"term_length_day,omitempty"`
 }
 
+// TermDescription returns a human-readable description of the deposit period combining
+// TermLengthYear, TermLengthMonth and TermLengthDay, e.g. "1 year 6 months".
+// Nil and zero fields are omitted. It returns an empty string if no term length is available.
+func (t TermDeposit) TermDescription() string {
+	var parts []string
+	for _, term := range []struct {
+		value *int
+		unit  string
+	}{
+		{t.TermLengthYear, "year"},
+		{t.TermLengthMonth, "month"},
+		{t.TermLengthDay, "day"},
+	} {
+		if term.value == nil || *term.value == 0 {
+			continue
+		}
+		if *term.value == 1 {
+			parts = append(parts, fmt.Sprintf("1 %s", term.unit))
+		} else {
+			parts = append(parts, fmt.Sprintf("%d %ss", *term.value, term.unit))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// ApproxDuration returns the deposit period as a time.Duration, treating nil fields as zero.
+// The value is approximate: a year is counted as 365 days and a month as 30 days.
+// Use MaturityDate when the exact end of the term is needed.
+func (t TermDeposit) ApproxDuration() time.Duration {
+	const day = 24 * time.Hour
+	var d time.Duration
+	if t.TermLengthYear != nil {
+		d += time.Duration(*t.TermLengthYear) * 365 * day
+	}
+	if t.TermLengthMonth != nil {
+		d += time.Duration(*t.TermLengthMonth) * 30 * day
+	}
+	if t.TermLengthDay != nil {
+		d += time.Duration(*t.TermLengthDay) * day
+	}
+	return d
+}
+
+// ParsedMaturityDate parses MaturityDate as a "2006-01-02" date in UTC, or with the first of layouts that matches it
+// if any are given, e.g. the Config.DateLayouts returned by Client.DateLayouts.
+// The returned bool reports whether MaturityDate is present; it is false with a nil error when MaturityDate is nil.
+func (t TermDeposit) ParsedMaturityDate(layouts ...string) (time.Time, bool, error) {
+	return parseOptionalTime("maturity date", t.MaturityDate, layoutsOr(layouts, time.DateOnly))
+}
+
+// FilterTermDepositsMaturingBefore returns the deposits whose maturity date is before t, preserving their order.
+// Deposits without a maturity date or with a maturity date that cannot be parsed are excluded.
+//
+// Example:
+//
+//	response, err := client.GetTermDeposits(ctx, "account_key_123")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	endOfQuarter := time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)
+//	maturing := moneytree.FilterTermDepositsMaturingBefore(response.TermDeposits, endOfQuarter)
+func FilterTermDepositsMaturingBefore(deposits []TermDeposit, t time.Time) []TermDeposit {
+	var filtered []TermDeposit
+	for _, deposit := range deposits {
+		maturityDate, ok, err := deposit.ParsedMaturityDate()
+		if !ok || err != nil {
+			continue
+		}
+		if maturityDate.Before(t) {
+			filtered = append(filtered, deposit)
+		}
+	}
+	return filtered
+}
+
 // TermDeposits represents the response from the term deposits endpoint.
 type TermDeposits struct {
 	// TermDeposits is a list of term deposit records for the account.
@@ -360,7 +618,7 @@ func (c *Client) GetTermDeposits(ctx context.Context, accountID string, opts ...
 		opt(options)
 	}
 
-	urlPath := fmt.Sprintf("link/accounts/%s/term_deposits.json", url.PathEscape(accountID))
+	urlPath := fmt.Sprintf("link/accounts/%s/term_deposits.json", escapePathSegment(accountID))
 	queryParams := url.Values{}
 	if options.Page != nil {
 		queryParams.Set("page", fmt.Sprintf("%d", *options.Page))
@@ -396,6 +654,79 @@ type PersonalAccountTransactionAttributes struct {
 	// DataSource indicates the data source.
 	// Deprecated: This field is deprecated.
 	DataSource *string `json:"data_source,omitempty"`
+	// Extra holds the members of the attributes object that are not modeled above, keyed by name.
+	// The LINK API has no separate endpoint for merchant enrichment and documents no enrichment
+	// fields, so any such data returned for a subtype is kept here undecoded. It is nil if there are none.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes the attributes object, keeping unmodeled members in Extra.
+func (a *PersonalAccountTransactionAttributes) UnmarshalJSON(data []byte) error {
+	// The alias type has the same fields without this method, which avoids infinite recursion.
+	type attributes PersonalAccountTransactionAttributes
+	var decoded attributes
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(data, &members); err != nil {
+		return err
+	}
+	for _, name := range jsonMemberNames(reflect.TypeFor[attributes]()) {
+		delete(members, name)
+	}
+	if len(members) > 0 {
+		decoded.Extra = members
+	}
+
+	*a = PersonalAccountTransactionAttributes(decoded)
+	return nil
+}
+
+// MarshalJSON encodes the attributes object with the members in Extra merged back in,
+// so that a decoded object is encoded again without losing them.
+// A modeled member takes precedence over a member of the same name in Extra.
+func (a PersonalAccountTransactionAttributes) MarshalJSON() ([]byte, error) {
+	// The alias type has the same fields without this method, which avoids infinite recursion.
+	type attributes PersonalAccountTransactionAttributes
+	data, err := json.Marshal(attributes(a))
+	if err != nil || len(a.Extra) == 0 {
+		return data, err
+	}
+
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(data, &members); err != nil {
+		return nil, err
+	}
+	for name, value := range a.Extra {
+		if _, ok := members[name]; !ok {
+			members[name] = value
+		}
+	}
+	return json.Marshal(members)
+}
+
+// jsonMemberNames returns the names of the JSON object members that the fields of the struct type t are
+// encoded to and decoded from, following their json tags. Fields that encoding/json ignores are skipped.
+func jsonMemberNames(t reflect.Type) []string {
+	var names []string
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = field.Name
+		}
+		names = append(names, name)
+	}
+	return names
 }
 
 // PersonalAccountTransaction represents a transaction record for a personal account returned by the Moneytree LINK API.
@@ -447,9 +778,11 @@ type GetPersonalAccountTransactionsOption func(*getTransactionsOptions)
 
 type getTransactionsOptions struct {
 	paginationOptions
-	SortKey *string
-	SortBy  *string
-	Since   *string
+	SortKey        *string
+	SortBy         *string
+	Since          *string
+	SinceExclusive bool
+	UpdatedSince   *time.Time
 }
 
 // WithPageForTransactions specifies the page number for pagination.
@@ -474,7 +807,7 @@ func WithPerPageForTransactions(perPage int) GetPersonalAccountTransactionsOptio
 // Using sort_key may affect response time, so it is recommended to use it only when necessary.
 // If "date" is specified as the sort key, the database sorts by the transaction date
 // (which is the actual transaction date, not the date Moneytree obtained it).
-// The default value is "id".
+// The default value is "id". The possible values are listed by SupportedTransactionSortKeys.
 func WithSortKeyForTransactions(sortKey string) GetPersonalAccountTransactionsOption {
 	return func(opts *getTransactionsOptions) {
 		opts.SortKey = &sortKey
@@ -499,6 +832,241 @@ func WithSinceForTransactions(since string) GetPersonalAccountTransactionsOption
 	}
 }
 
+// WithSinceInclusiveForTransactions specifies whether records updated on the since date itself are returned.
+// The API includes the boundary day, so consecutive syncs that reuse the date of the previous sync
+// receive the records updated on that day again. With inclusive set to false, those records are
+// dropped on the client side: a transaction is dropped if the date part of its updated_at, in the
+// time zone offset given by the API, equals the since date. Transactions whose updated_at cannot be
+// parsed are kept. The default is true, which returns the API response unchanged.
+// This option has no effect unless WithSinceForTransactions is also specified.
+//
+// Dropping the whole day loses the records edited on the since date after the previous sync read them,
+// as a date cannot tell them apart from the records that were already read. To resume a sync without
+// missing any edit, use WithUpdatedSinceForTransactions with the time of the previous sync instead.
+//
+// Note that dropped records make a page shorter than per_page, so do not use the page length
+// to detect the last page when inclusive is false.
+func WithSinceInclusiveForTransactions(inclusive bool) GetPersonalAccountTransactionsOption {
+	return func(opts *getTransactionsOptions) {
+		opts.SinceExclusive = !inclusive
+	}
+}
+
+// WithUpdatedSinceForTransactions retrieves only the records updated at or after since (updated_at),
+// e.g. the time of the previous sync. The API only takes a date, so the since parameter is set to the
+// date of since in UTC and the records updated earlier that day are dropped on the client side with
+// FilterTransactionsUpdatedSince; records whose updated_at cannot be parsed are kept. It replaces
+// WithSinceForTransactions.
+//
+// Note that dropped records make a page shorter than per_page, so do not use the page length
+// to detect the last page.
+//
+// Example:
+//
+//	response, err := client.GetPersonalAccountTransactions(ctx, "account_key_123",
+//		moneytree.WithUpdatedSinceForTransactions(lastSync),
+//	)
+func WithUpdatedSinceForTransactions(since time.Time) GetPersonalAccountTransactionsOption {
+	return func(opts *getTransactionsOptions) {
+		date := since.UTC().Format(time.DateOnly)
+		opts.Since = &date
+		opts.UpdatedSince = &since
+	}
+}
+
+// filter drops the transactions excluded by WithSinceInclusiveForTransactions and WithUpdatedSinceForTransactions.
+// It returns nil if the options need no filtering on the client side.
+func (o *getTransactionsOptions) filter() func([]PersonalAccountTransaction) []PersonalAccountTransaction {
+	exclusiveSince := o.Since != nil && o.SinceExclusive
+	if !exclusiveSince && o.UpdatedSince == nil {
+		return nil
+	}
+	since := o.Since
+	updatedSince := o.UpdatedSince
+	return func(transactions []PersonalAccountTransaction) []PersonalAccountTransaction {
+		if exclusiveSince {
+			transactions = excludeUpdatedOn(transactions, *since)
+		}
+		if updatedSince != nil {
+			transactions = FilterTransactionsUpdatedSince(transactions, *updatedSince)
+		}
+		return transactions
+	}
+}
+
+// validate returns all problems with the options, joined with errors.Join.
+func (o *getTransactionsOptions) validate() error {
+	return validateListOptions(&o.paginationOptions, o.SortKey, o.SortBy, o.Since)
+}
+
+// ValidatePersonalAccountTransactionsOptions validates opts as GetPersonalAccountTransactions does,
+// without sending a request, e.g. to check options read from a configuration file at startup.
+// Every problem is reported at once: the returned error joins one error per invalid option
+// (see errors.Join), and is nil if all options are valid.
+//
+// Example:
+//
+//	err := moneytree.ValidatePersonalAccountTransactionsOptions(
+//		moneytree.WithSinceForTransactions(since),
+//		moneytree.WithSortByForTransactions(sortBy),
+//		moneytree.WithPerPageForTransactions(perPage),
+//	)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func ValidatePersonalAccountTransactionsOptions(opts ...GetPersonalAccountTransactionsOption) error {
+	options := &getTransactionsOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return options.validate()
+}
+
+// personalAccountTransactionsQuery validates options and returns the query parameters
+// of the personal account transactions endpoint, other than the pagination parameters.
+func (c *Client) personalAccountTransactionsQuery(options *getTransactionsOptions) (url.Values, error) {
+	if !c.skipClientValidation() {
+		if err := options.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := c.validateSinceNotInFuture(options.Since); err != nil {
+			return nil, err
+		}
+	}
+
+	queryParams := url.Values{}
+	if options.SortKey != nil {
+		queryParams.Set("sort_key", *options.SortKey)
+	}
+	if options.SortBy != nil {
+		queryParams.Set("sort_by", *options.SortBy)
+	}
+	if options.Since != nil {
+		queryParams.Set("since", *options.Since)
+	}
+	return queryParams, nil
+}
+
+// excludeUpdatedOn returns the transactions whose updated_at does not fall on date ("2006-01-02").
+// Transactions whose updated_at cannot be parsed are kept.
+func excludeUpdatedOn(transactions []PersonalAccountTransaction, date string) []PersonalAccountTransaction {
+	filtered := make([]PersonalAccountTransaction, 0, len(transactions))
+	for _, transaction := range transactions {
+		updatedAt, err := transaction.ParsedUpdatedAt()
+		if err == nil && updatedAt.Format(time.DateOnly) == date {
+			continue
+		}
+		filtered = append(filtered, transaction)
+	}
+	return filtered
+}
+
+// FilterTransactionsUpdatedSince returns the transactions updated at or after since, whatever their
+// transaction date, so that an incremental sync also picks up corrections to old transactions.
+// Transactions whose UpdatedAt cannot be parsed are kept, so that no edit is missed.
+// The order of txs is preserved.
+//
+// The since parameter of the API already filters on updated_at rather than on the transaction date,
+// but only by day; the API has no parameter taking a timestamp. Request the day of since with
+// WithSinceForTransactions and apply this helper to drop the records updated earlier that day.
+//
+// Example:
+//
+//	response, err := client.GetPersonalAccountTransactions(ctx, "account_key_123",
+//		moneytree.WithSinceForTransactions(lastSync.UTC().Format(time.DateOnly)),
+//	)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	updated := moneytree.FilterTransactionsUpdatedSince(response.Transactions, lastSync)
+func FilterTransactionsUpdatedSince(txs []PersonalAccountTransaction, since time.Time) []PersonalAccountTransaction {
+	filtered := make([]PersonalAccountTransaction, 0, len(txs))
+	for _, tx := range txs {
+		updatedAt, err := tx.ParsedUpdatedAt()
+		if err == nil && updatedAt.Before(since) {
+			continue
+		}
+		filtered = append(filtered, tx)
+	}
+	return filtered
+}
+
+// FilterTransactionsByCategory returns the transactions whose CategoryID is one of categoryIDs,
+// preserving the order of txs. It returns no transactions if categoryIDs is empty.
+//
+// The filtering happens on the client: the transactions endpoints accept no category parameter,
+// only the date and sort parameters, so every transaction is still fetched.
+//
+// Example:
+//
+//	response, err := client.GetPersonalAccountTransactions(ctx, "account_key_123")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	groceries := moneytree.FilterTransactionsByCategory(response.Transactions, groceriesCategoryID)
+func FilterTransactionsByCategory(txs []PersonalAccountTransaction, categoryIDs ...int64) []PersonalAccountTransaction {
+	filtered := make([]PersonalAccountTransaction, 0, len(txs))
+	for _, tx := range txs {
+		if slices.Contains(categoryIDs, tx.CategoryID) {
+			filtered = append(filtered, tx)
+		}
+	}
+	return filtered
+}
+
+// FilterTransactionsByDateRange returns the transactions whose transaction date falls within [start, end]
+// (both inclusive), preserving the order of txs. It applies to the investment and point account
+// transactions as well, which share the PersonalAccountTransaction type.
+// An error is returned if start is after end or if the Date of a transaction cannot be parsed.
+//
+// The filtering happens on the client: the transactions endpoints accept no until parameter, and their
+// since parameter filters on updated_at rather than on the transaction date. As a transaction cannot be
+// updated before it occurred, request the day before start with the since option to reduce the amount
+// of data fetched. To do so across all personal accounts, see GetTransactionsByDateRange.
+//
+// Example:
+//
+//	jst := time.FixedZone("JST", 9*60*60)
+//	start := time.Date(2023, time.March, 1, 0, 0, 0, 0, jst)
+//	end := start.AddDate(0, 1, 0).Add(-time.Nanosecond)
+//	response, err := client.GetInvestmentAccountTransactions(ctx, "account_key_123",
+//		moneytree.WithSinceForInvestmentTransactions(start.AddDate(0, 0, -1).Format(time.DateOnly)),
+//	)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	march, err := moneytree.FilterTransactionsByDateRange(response.Transactions, start, end)
+func FilterTransactionsByDateRange(txs []PersonalAccountTransaction, start, end time.Time) ([]PersonalAccountTransaction, error) {
+	if start.After(end) {
+		return nil, fmt.Errorf("start must not be after end, got start: %s, end: %s", start.Format(time.RFC3339), end.Format(time.RFC3339))
+	}
+	filtered, parseErrs := filterTransactionsInRange(txs, start, end)
+	if len(parseErrs) > 0 {
+		return nil, parseErrs[0]
+	}
+	return filtered, nil
+}
+
+// filterTransactionsInRange returns the transactions of txs dated within [start, end], preserving their order,
+// and an error for each transaction whose Date cannot be parsed, which is left out.
+func filterTransactionsInRange(txs []PersonalAccountTransaction, start, end time.Time) ([]PersonalAccountTransaction, []error) {
+	filtered := make([]PersonalAccountTransaction, 0, len(txs))
+	var parseErrs []error
+	for _, tx := range txs {
+		date, err := tx.ParsedDate()
+		if err != nil {
+			parseErrs = append(parseErrs, fmt.Errorf("failed to parse date of transaction %d: %w", tx.ID, err))
+			continue
+		}
+		if date.Before(start) || date.After(end) {
+			continue
+		}
+		filtered = append(filtered, tx)
+	}
+	return filtered, parseErrs
+}
+
 // GetPersonalAccountTransactions retrieves the transaction records for a specific personal account.
 // This endpoint requires the transactions_read OAuth scope.
 //
@@ -541,30 +1109,14 @@ func (c *Client) GetPersonalAccountTransactions(ctx context.Context, accountID s
 		opt(options)
 	}
 
-	if options.Since != nil {
-		if err := validateDateFormat(*options.Since); err != nil {
-			return nil, err
-		}
-	}
-
-	if options.SortBy != nil {
-		if *options.SortBy != "asc" && *options.SortBy != "desc" {
-			return nil, fmt.Errorf("sort_by must be 'asc' or 'desc', got: %s", *options.SortBy)
-		}
-	}
-
-	urlPath := fmt.Sprintf("link/accounts/%s/transactions.json", url.PathEscape(accountID))
-	queryParams := url.Values{}
-	applyPaginationParams(queryParams, &options.paginationOptions)
-	if options.SortKey != nil {
-		queryParams.Set("sort_key", *options.SortKey)
-	}
-	if options.SortBy != nil {
-		queryParams.Set("sort_by", *options.SortBy)
+	queryParams, err := c.personalAccountTransactionsQuery(options)
+	if err != nil {
+		return nil, err
 	}
-	if options.Since != nil {
-		queryParams.Set("since", *options.Since)
+	if err := c.applyPagination(queryParams, &options.paginationOptions); err != nil {
+		return nil, err
 	}
+	urlPath := fmt.Sprintf("link/accounts/%s/transactions.json", escapePathSegment(accountID))
 	if len(queryParams) > 0 {
 		urlPath = fmt.Sprintf("%s?%s", urlPath, queryParams.Encode())
 	}
@@ -578,9 +1130,143 @@ func (c *Client) GetPersonalAccountTransactions(ctx context.Context, accountID s
 	if _, err = c.Do(ctx, httpReq, &res); err != nil {
 		return nil, err
 	}
+	if filter := options.filter(); filter != nil {
+		res.Transactions = filter(res.Transactions)
+	}
 	return &res, nil
 }
 
+// PersonalAccountTransactionsPager returns a Pager over the transaction records of a personal account,
+// for callers that want to request each page explicitly instead of iterating over the results of
+// GetPersonalAccountTransactions page by page.
+// This endpoint requires the transactions_read OAuth scope.
+//
+// The options are those of GetPersonalAccountTransactions. The Pager starts at the page given by
+// WithPageForTransactions, or page 1, and requests WithPerPageForTransactions items per page,
+// or 500 if it is not specified. Invalid options are reported by the first call to Next.
+//
+// Example:
+//
+//	pager := client.PersonalAccountTransactionsPager("account_key_123",
+//		moneytree.WithSinceForTransactions("2023-01-01"),
+//	)
+//	for pager.HasMore() {
+//		transactions, err := pager.Next(ctx)
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		fmt.Printf("%d transactions\n", len(transactions))
+//	}
+//
+// Reference: https://docs.link.getmoneytree.com/reference/get-link-accounts-transactions
+func (c *Client) PersonalAccountTransactionsPager(accountID string, opts ...GetPersonalAccountTransactionsOption) *Pager[PersonalAccountTransaction] {
+	if accountID == "" {
+		return failedPager[PersonalAccountTransaction](fmt.Errorf("account ID is required"))
+	}
+
+	options := &getTransactionsOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	queryParams, err := c.personalAccountTransactionsQuery(options)
+	if err != nil {
+		return failedPager[PersonalAccountTransaction](err)
+	}
+
+	firstPage := 1
+	if options.Page != nil {
+		firstPage = *options.Page
+	}
+	perPage := maxPerPage
+	if options.PerPage != nil {
+		perPage = *options.PerPage
+	}
+
+	urlPath := fmt.Sprintf("link/accounts/%s/transactions.json", escapePathSegment(accountID))
+	pager := newPager(urlPath, queryParams, firstPage, perPage,
+		listPageFetcher(c, func(res *PersonalAccountTransactions) []PersonalAccountTransaction { return res.Transactions }))
+	pager.filter = options.filter()
+	return pager
+}
+
+// GetTransactionsByDateRange retrieves the transactions of all personal accounts whose transaction date
+// falls within [start, end] (both inclusive).
+// This endpoint requires the accounts_read and transactions_read OAuth scopes.
+//
+// This helper lists all personal accounts, fetches every page of each account's transactions,
+// and merges them into a single slice grouped by account in the order returned by GetPersonalAccounts.
+// Up to WithConcurrency accounts are fetched at the same time. If any request fails,
+// the remaining requests are canceled and the error is returned, unless WithErrorPolicy
+// specifies ErrorPolicyCollect, in which case the transactions of the accounts that could be
+// fetched are returned along with the errors. A failure to list the accounts is always returned alone.
+//
+// A transaction whose Date cannot be parsed is skipped and reported in the returned error,
+// so the call fails under the default ErrorPolicyFailFast. With ErrorPolicyCollect, the other
+// transactions of its account are still returned along with the error.
+//
+// The LINK API has no parameter to filter transactions by transaction date: the since parameter
+// filters on updated_at. Because a transaction cannot be updated before it occurred, since is set
+// to the day before start in the local time zone of the Client, as for Config.RejectFutureSince
+// (the day before absorbs time zone differences), to reduce the amount of data fetched,
+// and the transaction date range is then applied on the client side.
+//
+// Example:
+//
+//	jst := time.FixedZone("JST", 9*60*60)
+//	start := time.Date(2023, time.March, 1, 0, 0, 0, 0, jst)
+//	end := start.AddDate(0, 1, 0).Add(-time.Nanosecond)
+//	transactions, err := client.GetTransactionsByDateRange(ctx, start, end)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, transaction := range transactions {
+//		fmt.Printf("Date: %s, Amount: %v\n", transaction.Date, transaction.Amount)
+//	}
+func (c *Client) GetTransactionsByDateRange(ctx context.Context, start, end time.Time, opts ...AggregateOption) ([]PersonalAccountTransaction, error) {
+	if start.After(end) {
+		return nil, fmt.Errorf("start must not be after end, got start: %s, end: %s", start.Format(time.RFC3339), end.Format(time.RFC3339))
+	}
+	options := newAggregateOptions(opts)
+
+	accounts, err := fetchAllPages(ctx, c.paginationTimeout, "link/accounts.json", url.Values{}, options.perPage(),
+		listPageFetcher(c, func(res *PersonalAccounts) []PersonalAccount { return res.Accounts }))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get personal accounts: %w", err)
+	}
+
+	queryParams := url.Values{}
+	location := c.getClock().Now().Location()
+	queryParams.Set("since", start.In(location).AddDate(0, 0, -1).Format(time.DateOnly))
+
+	perAccount := make([][]PersonalAccountTransaction, len(accounts))
+	err = options.run(ctx, len(accounts), func(ctx context.Context, i int) error {
+		accountKey := accounts[i].AccountKey
+		urlPath := fmt.Sprintf("link/accounts/%s/transactions.json", escapePathSegment(accountKey))
+		transactions, err := fetchAllPages(ctx, c.paginationTimeout, urlPath, queryParams, options.perPage(),
+			listPageFetcher(c, func(res *PersonalAccountTransactions) []PersonalAccountTransaction { return res.Transactions }))
+		if err != nil {
+			return fmt.Errorf("failed to get transactions for account %s: %w", accountKey, err)
+		}
+
+		inRange, parseErrs := filterTransactionsInRange(transactions, start, end)
+		perAccount[i] = inRange
+		if len(parseErrs) > 0 {
+			return fmt.Errorf("skipped transactions of account %s: %w", accountKey, errors.Join(parseErrs...))
+		}
+		return nil
+	})
+	if err != nil && options.ErrorPolicy != ErrorPolicyCollect {
+		return nil, err
+	}
+
+	var res []PersonalAccountTransaction
+	for _, transactions := range perAccount {
+		res = append(res, transactions...)
+	}
+	return res, err
+}
+
 // UpdatePersonalAccountTransactionRequest represents a request to update a personal account transaction.
 type UpdatePersonalAccountTransactionRequest struct {
 	// Date is the transaction date.
@@ -651,7 +1337,7 @@ func (c *Client) UpdatePersonalAccountTransaction(ctx context.Context, accountID
 		return nil, fmt.Errorf("description_guest must be 255 characters or less, got %d characters", len(*req.DescriptionGuest))
 	}
 
-	urlPath := fmt.Sprintf("link/accounts/%s/transactions/%d.json", url.PathEscape(accountID), transactionID)
+	urlPath := fmt.Sprintf("link/accounts/%s/transactions/%d.json", escapePathSegment(accountID), transactionID)
 
 	httpReq, err := c.NewRequest(ctx, http.MethodPut, urlPath, req)
 	if err != nil {
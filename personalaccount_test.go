@@ -5,11 +5,17 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/rand"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"reflect"
+	"slices"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestGetPersonalAccounts(t *testing.T) {
@@ -20,11 +26,11 @@ func TestGetPersonalAccounts(t *testing.T) {
 
 		id1 := int64(123)
 		id2 := int64(456)
-		name1 := stringPtr("普通預金")
-		name2 := stringPtr("クレジットカード")
-		balance1 := float64Ptr(100000.50)
-		balance2 := float64Ptr(-5000.00)
-		currency := stringPtr("JPY")
+		name1 := StringPtr("普通預金")
+		name2 := StringPtr("クレジットカード")
+		balance1 := Float64Ptr(100000.50)
+		balance2 := Float64Ptr(-5000.00)
+		currency := StringPtr("JPY")
 		lastAggregatedAt := "2023-01-01"
 
 		expectedResponse := PersonalAccounts{
@@ -38,7 +44,7 @@ func TestGetPersonalAccounts(t *testing.T) {
 					Name:                 name1,
 					Balance:              balance1,
 					Currency:             currency,
-					LastAggregatedAt:     stringPtr(lastAggregatedAt),
+					LastAggregatedAt:     StringPtr(lastAggregatedAt),
 				},
 				{
 					ID:                   &id2,
@@ -49,7 +55,7 @@ func TestGetPersonalAccounts(t *testing.T) {
 					Name:                 name2,
 					Balance:              balance2,
 					Currency:             currency,
-					LastAggregatedAt:     stringPtr(lastAggregatedAt),
+					LastAggregatedAt:     StringPtr(lastAggregatedAt),
 				},
 			},
 		}
@@ -132,7 +138,7 @@ func TestGetPersonalAccounts(t *testing.T) {
 		t.Parallel()
 
 		accountKey := "account_key_1"
-		name := stringPtr("普通預金")
+		name := StringPtr("普通預金")
 
 		expectedResponse := PersonalAccounts{
 			Accounts: []PersonalAccount{
@@ -143,7 +149,7 @@ func TestGetPersonalAccounts(t *testing.T) {
 					AccountType:          "bank",
 					Name:                 name,
 					Balance:              nil,
-					Currency:             stringPtr("JPY"),
+					Currency:             StringPtr("JPY"),
 					LastAggregatedAt:     nil,
 				},
 			},
@@ -237,9 +243,9 @@ func TestGetPersonalAccounts(t *testing.T) {
 	t.Run("success case: accounts list with page parameter", func(t *testing.T) {
 		t.Parallel()
 
-		name := stringPtr("普通預金")
-		balance := float64Ptr(100000.50)
-		currency := stringPtr("JPY")
+		name := StringPtr("普通預金")
+		balance := Float64Ptr(100000.50)
+		currency := StringPtr("JPY")
 
 		expectedResponse := PersonalAccounts{
 			Accounts: []PersonalAccount{
@@ -305,9 +311,9 @@ func TestGetPersonalAccounts(t *testing.T) {
 	t.Run("success case: accounts list with per_page parameter", func(t *testing.T) {
 		t.Parallel()
 
-		name := stringPtr("普通預金")
-		balance := float64Ptr(100000.50)
-		currency := stringPtr("JPY")
+		name := StringPtr("普通預金")
+		balance := Float64Ptr(100000.50)
+		currency := StringPtr("JPY")
 
 		expectedResponse := PersonalAccounts{
 			Accounts: []PersonalAccount{
@@ -367,9 +373,9 @@ func TestGetPersonalAccounts(t *testing.T) {
 	t.Run("success case: accounts list with both page and per_page parameters", func(t *testing.T) {
 		t.Parallel()
 
-		name := stringPtr("普通預金")
-		balance := float64Ptr(100000.50)
-		currency := stringPtr("JPY")
+		name := StringPtr("普通預金")
+		balance := Float64Ptr(100000.50)
+		currency := StringPtr("JPY")
 
 		expectedResponse := PersonalAccounts{
 			Accounts: []PersonalAccount{
@@ -514,10 +520,6 @@ func TestGetPersonalAccounts(t *testing.T) {
 	})
 }
 
-func float64Ptr(f float64) *float64 {
-	return &f
-}
-
 func TestWithSinceForBalances_InvalidDateFormat(t *testing.T) {
 	t.Parallel()
 
@@ -607,6 +609,140 @@ func TestWithSinceForBalances_InvalidDateFormat(t *testing.T) {
 	})
 }
 
+func TestGetPersonalAccount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: account is retrieved correctly", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				t.Errorf("expected method %s, got %s", http.MethodGet, r.Method)
+			}
+			if r.URL.Path != "/link/accounts/account_key_123.json" {
+				t.Errorf("expected path /link/accounts/account_key_123.json, got %s", r.URL.Path)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{
+				"id": 1048,
+				"account_key": "account_key_123",
+				"account_type": "bank",
+				"currency": "JPY",
+				"balance": 1000000.0
+			}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+		setTestToken(client, "test-access-token")
+
+		account, err := client.GetPersonalAccount(context.Background(), "account_key_123")
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if account.ID == nil || *account.ID != 1048 {
+			t.Errorf("expected ID 1048, got %v", account.ID)
+		}
+		if account.AccountKey != "account_key_123" {
+			t.Errorf("expected AccountKey account_key_123, got %s", account.AccountKey)
+		}
+		if account.AccountType != "bank" {
+			t.Errorf("expected AccountType bank, got %s", account.AccountType)
+		}
+		if account.Currency == nil || *account.Currency != "JPY" {
+			t.Errorf("expected Currency JPY, got %v", account.Currency)
+		}
+	})
+
+	t.Run("error case: returns error when account key is empty", func(t *testing.T) {
+		t.Parallel()
+
+		baseURL, err := url.Parse("https://test.getmoneytree.com/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+		setTestToken(client, "test-token")
+
+		_, err = client.GetPersonalAccount(context.Background(), "")
+		if err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+
+	t.Run("error case: returns ErrNotFound when the account does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error": "not_found", "error_description": "Account not found."}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+		setTestToken(client, "test-token")
+
+		_, err = client.GetPersonalAccount(context.Background(), "missing")
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("expected ErrNotFound, got %v", err)
+		}
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected APIError, got %T", err)
+		}
+		if apiErr.StatusCode != http.StatusNotFound {
+			t.Errorf("expected status code %d, got %d", http.StatusNotFound, apiErr.StatusCode)
+		}
+	})
+
+	t.Run("error case: returns ErrNoToken when no token is set", func(t *testing.T) {
+		t.Parallel()
+
+		baseURL, err := url.Parse("https://test.getmoneytree.com/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		_, err = client.GetPersonalAccount(context.Background(), "account_key_123")
+		if !errors.Is(err, ErrNoToken) {
+			t.Errorf("expected ErrNoToken, got %v", err)
+		}
+	})
+}
+
 func TestGetPersonalAccountBalances(t *testing.T) {
 	t.Parallel()
 
@@ -630,14 +766,14 @@ func TestGetPersonalAccountBalances(t *testing.T) {
 					ID:            id1,
 					AccountID:     accountIDValue,
 					Date:          date1,
-					Balance:       balance1,
+					Balance:       &balance1,
 					BalanceInBase: balanceInBase1,
 				},
 				{
 					ID:            id2,
 					AccountID:     accountIDValue,
 					Date:          date2,
-					Balance:       balance2,
+					Balance:       &balance2,
 					BalanceInBase: balanceInBase2,
 				},
 			},
@@ -696,8 +832,8 @@ func TestGetPersonalAccountBalances(t *testing.T) {
 		if bal1.AccountID != expectedResponse.AccountBalances[0].AccountID {
 			t.Errorf("expected AccountID %d, got %d", expectedResponse.AccountBalances[0].AccountID, bal1.AccountID)
 		}
-		if bal1.Balance != expectedResponse.AccountBalances[0].Balance {
-			t.Errorf("expected Balance %v, got %v", expectedResponse.AccountBalances[0].Balance, bal1.Balance)
+		if bal1.Balance == nil || *bal1.Balance != *expectedResponse.AccountBalances[0].Balance {
+			t.Errorf("expected Balance %v, got %v", *expectedResponse.AccountBalances[0].Balance, bal1.Balance)
 		}
 		if bal1.BalanceInBase != expectedResponse.AccountBalances[0].BalanceInBase {
 			t.Errorf("expected BalanceInBase %v, got %v", expectedResponse.AccountBalances[0].BalanceInBase, bal1.BalanceInBase)
@@ -707,8 +843,8 @@ func TestGetPersonalAccountBalances(t *testing.T) {
 		}
 
 		bal2 := response.AccountBalances[1]
-		if bal2.Balance != expectedResponse.AccountBalances[1].Balance {
-			t.Errorf("expected Balance %v, got %v", expectedResponse.AccountBalances[1].Balance, bal2.Balance)
+		if bal2.Balance == nil || *bal2.Balance != *expectedResponse.AccountBalances[1].Balance {
+			t.Errorf("expected Balance %v, got %v", *expectedResponse.AccountBalances[1].Balance, bal2.Balance)
 		}
 		if bal2.BalanceInBase != expectedResponse.AccountBalances[1].BalanceInBase {
 			t.Errorf("expected BalanceInBase %v, got %v", expectedResponse.AccountBalances[1].BalanceInBase, bal2.BalanceInBase)
@@ -735,7 +871,7 @@ func TestGetPersonalAccountBalances(t *testing.T) {
 					ID:            id,
 					AccountID:     accountIDValue,
 					Date:          date,
-					Balance:       balance,
+					Balance:       &balance,
 					BalanceInBase: balanceInBase,
 				},
 			},
@@ -781,6 +917,87 @@ func TestGetPersonalAccountBalances(t *testing.T) {
 		}
 	})
 
+	t.Run("success case: balances list with start_date and end_date parameters", func(t *testing.T) {
+		t.Parallel()
+
+		accountID := "account_key_123"
+		startDate := "2023-01-01"
+		endDate := "2023-01-31"
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if actual := r.URL.Query().Get("start_date"); actual != startDate {
+				t.Errorf("expected start_date parameter %s, got %s", startDate, actual)
+			}
+			if actual := r.URL.Query().Get("end_date"); actual != endDate {
+				t.Errorf("expected end_date parameter %s, got %s", endDate, actual)
+			}
+			if r.URL.Query().Has("until") {
+				t.Errorf("expected no until parameter, got %s", r.URL.RawQuery)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			if err := json.NewEncoder(w).Encode(PersonalAccountBalances{}); err != nil {
+				t.Errorf("failed to encode response: %v", err)
+			}
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		setTestToken(client, "test-access-token")
+		_, err = client.GetPersonalAccountBalances(context.Background(), accountID,
+			WithStartDateForBalances(startDate),
+			WithEndDateForBalances(endDate),
+		)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+	})
+
+	t.Run("error case: returns error when the date window is invalid", func(t *testing.T) {
+		t.Parallel()
+
+		baseURL, err := url.Parse("https://test.getmoneytree.com/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+		setTestToken(client, "test-token")
+
+		tests := []struct {
+			name    string
+			opts    []GetPersonalAccountBalancesOption
+			wantErr string
+		}{
+			{name: "start_date without end_date", opts: []GetPersonalAccountBalancesOption{WithStartDateForBalances("2023-01-01")}, wantErr: "end_date is required"},
+			{name: "end_date without start_date", opts: []GetPersonalAccountBalancesOption{WithEndDateForBalances("2023-01-31")}, wantErr: "start_date is required"},
+			{name: "invalid format", opts: []GetPersonalAccountBalancesOption{WithStartDateForBalances("2023-01-01"), WithEndDateForBalances("2023/01/31")}, wantErr: "date must be in format YYYY-MM-DD"},
+			{name: "start_date after end_date", opts: []GetPersonalAccountBalancesOption{WithStartDateForBalances("2023-02-01"), WithEndDateForBalances("2023-01-31")}, wantErr: "start_date must be on or before end_date"},
+		}
+		for _, tt := range tests {
+			_, err := client.GetPersonalAccountBalances(context.Background(), "account_key_123", tt.opts...)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("%s: expected error containing %q, got %v", tt.name, tt.wantErr, err)
+			}
+		}
+	})
+
 	t.Run("success case: balances list with page and per_page parameters", func(t *testing.T) {
 		t.Parallel()
 
@@ -797,7 +1014,7 @@ func TestGetPersonalAccountBalances(t *testing.T) {
 					ID:            id,
 					AccountID:     accountIDValue,
 					Date:          date,
-					Balance:       balance,
+					Balance:       &balance,
 					BalanceInBase: balanceInBase,
 				},
 			},
@@ -897,6 +1114,53 @@ func TestGetPersonalAccountBalances(t *testing.T) {
 		}
 	})
 
+	t.Run("success case: null balance is decoded as nil and zero balance as zero", func(t *testing.T) {
+		t.Parallel()
+
+		accountID := "account_key_123"
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"account_balances": [
+				{"id": 1, "account_id": 123, "date": "2023-01-01", "balance": null, "balance_in_base": 0},
+				{"id": 2, "account_id": 123, "date": "2023-01-02", "balance": 0, "balance_in_base": 0}
+			]}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		setTestToken(client, "test-access-token")
+		response, err := client.GetPersonalAccountBalances(context.Background(), accountID)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+
+		if len(response.AccountBalances) != 2 {
+			t.Fatalf("expected 2 balances, got %d", len(response.AccountBalances))
+		}
+		if response.AccountBalances[0].Balance != nil {
+			t.Errorf("expected Balance nil for null, got %v", *response.AccountBalances[0].Balance)
+		}
+		if response.AccountBalances[1].Balance == nil {
+			t.Fatal("expected Balance 0 for zero, got nil")
+		}
+		if *response.AccountBalances[1].Balance != 0 {
+			t.Errorf("expected Balance 0, got %v", *response.AccountBalances[1].Balance)
+		}
+	})
+
 	t.Run("error case: returns error when access token is empty", func(t *testing.T) {
 		t.Parallel()
 
@@ -1004,6 +1268,100 @@ func TestGetPersonalAccountBalances(t *testing.T) {
 	})
 }
 
+func TestGetBalancesForAccounts(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: balances and errors are keyed by account without exceeding the concurrency", func(t *testing.T) {
+		t.Parallel()
+
+		var inFlight, maxInFlight atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				m := maxInFlight.Load()
+				if n <= m || maxInFlight.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Path == "/link/accounts/missing/balances.json" {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"error": "not_found"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"account_balances": [{"id": 1, "date": "2023-01-01", "balance": 1000}]}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+		setTestToken(client, "test-access-token")
+
+		accountKeys := []string{"missing", "a", "b", "c", "d", "e", "a"}
+		balances, errs := client.GetBalancesForAccounts(context.Background(), accountKeys, WithConcurrency(2))
+
+		if len(balances) != 5 {
+			t.Fatalf("expected 5 balances, got %d", len(balances))
+		}
+		for _, accountKey := range []string{"a", "b", "c", "d", "e"} {
+			if len(balances[accountKey].AccountBalances) != 1 {
+				t.Errorf("%s: expected 1 balance record, got %v", accountKey, balances[accountKey])
+			}
+		}
+		if len(errs) != 1 || !errors.Is(errs["missing"], ErrNotFound) {
+			t.Errorf("expected ErrNotFound for the missing account only, got %v", errs)
+		}
+		if got := maxInFlight.Load(); got > 2 {
+			t.Errorf("expected at most 2 concurrent requests, got %d", got)
+		}
+	})
+
+	t.Run("error case: canceled context is reported for every account", func(t *testing.T) {
+		t.Parallel()
+
+		baseURL, err := url.Parse("https://test.getmoneytree.com/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+		setTestToken(client, "test-access-token")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		balances, errs := client.GetBalancesForAccounts(ctx, []string{"a", "b", ""})
+
+		if len(balances) != 0 {
+			t.Errorf("expected 0 balances, got %d", len(balances))
+		}
+		for _, accountKey := range []string{"a", "b"} {
+			if !errors.Is(errs[accountKey], context.Canceled) {
+				t.Errorf("%s: expected context.Canceled, got %v", accountKey, errs[accountKey])
+			}
+		}
+		if errs[""] == nil {
+			t.Error("expected error for empty account key, got nil")
+		}
+	})
+}
+
 func TestGetTermDeposits(t *testing.T) {
 	t.Parallel()
 
@@ -1326,14 +1684,248 @@ func TestGetTermDeposits(t *testing.T) {
 	})
 }
 
-func TestGetPersonalAccountTransactions(t *testing.T) {
+func TestTermDeposit_TermLength(t *testing.T) {
 	t.Parallel()
 
-	t.Run("success case: transactions list is retrieved correctly", func(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+	const day = 24 * time.Hour
+
+	tests := []struct {
+		name                string
+		deposit             TermDeposit
+		expectedDescription string
+		expectedDuration    time.Duration
+	}{
+		{
+			name:                "success case: 2-year term",
+			deposit:             TermDeposit{TermLengthYear: intPtr(2)},
+			expectedDescription: "2 years",
+			expectedDuration:    730 * day,
+		},
+		{
+			name:                "success case: mixed term",
+			deposit:             TermDeposit{TermLengthYear: intPtr(1), TermLengthMonth: intPtr(6), TermLengthDay: intPtr(1)},
+			expectedDescription: "1 year 6 months 1 day",
+			expectedDuration:    (365 + 180 + 1) * day,
+		},
+		{
+			name:                "success case: zero fields are omitted",
+			deposit:             TermDeposit{TermLengthYear: intPtr(0), TermLengthMonth: intPtr(3), TermLengthDay: intPtr(0)},
+			expectedDescription: "3 months",
+			expectedDuration:    90 * day,
+		},
+		{
+			name:                "success case: all term length fields are nil",
+			deposit:             TermDeposit{},
+			expectedDescription: "",
+			expectedDuration:    0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.deposit.TermDescription(); got != tt.expectedDescription {
+				t.Errorf("expected description %q, got %q", tt.expectedDescription, got)
+			}
+			if got := tt.deposit.ApproxDuration(); got != tt.expectedDuration {
+				t.Errorf("expected duration %v, got %v", tt.expectedDuration, got)
+			}
+		})
+	}
+}
+
+func TestTermDeposit_ParsedMaturityDate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: nil maturity date is reported as absent", func(t *testing.T) {
 		t.Parallel()
 
-		descriptionGuest := "テスト取引"
-		descriptionPretty := "テスト取引（補正済み）"
+		maturityDate, ok, err := TermDeposit{}.ParsedMaturityDate()
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if ok {
+			t.Error("expected ok to be false")
+		}
+		if !maturityDate.IsZero() {
+			t.Errorf("expected zero time, got %v", maturityDate)
+		}
+	})
+
+	t.Run("success case: valid maturity date is parsed", func(t *testing.T) {
+		t.Parallel()
+
+		date := "2025-03-31"
+		maturityDate, ok, err := TermDeposit{MaturityDate: &date}.ParsedMaturityDate()
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if !ok {
+			t.Error("expected ok to be true")
+		}
+		expected := time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC)
+		if !maturityDate.Equal(expected) {
+			t.Errorf("expected %v, got %v", expected, maturityDate)
+		}
+	})
+
+	t.Run("error case: invalid maturity date returns error", func(t *testing.T) {
+		t.Parallel()
+
+		date := "2025/03/31"
+		_, ok, err := TermDeposit{MaturityDate: &date}.ParsedMaturityDate()
+		if err == nil {
+			t.Error("expected error, got nil")
+		}
+		if !ok {
+			t.Error("expected ok to be true")
+		}
+	})
+}
+
+func TestFilterTermDepositsMaturingBefore(t *testing.T) {
+	t.Parallel()
+
+	strPtr := func(v string) *string { return &v }
+	deposits := []TermDeposit{
+		{ID: 1, MaturityDate: strPtr("2024-03-31")},
+		{ID: 2, MaturityDate: strPtr("2024-04-01")},
+		{ID: 3},
+		{ID: 4, MaturityDate: strPtr("invalid")},
+		{ID: 5, MaturityDate: strPtr("2024-01-15")},
+	}
+
+	filtered := FilterTermDepositsMaturingBefore(deposits, time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC))
+
+	expectedIDs := []int64{1, 5}
+	if len(filtered) != len(expectedIDs) {
+		t.Fatalf("expected %d deposits, got %d", len(expectedIDs), len(filtered))
+	}
+	for i, id := range expectedIDs {
+		if filtered[i].ID != id {
+			t.Errorf("expected filtered[%d].ID %d, got %d", i, id, filtered[i].ID)
+		}
+	}
+}
+
+func TestFilterTransactionsUpdatedSince(t *testing.T) {
+	t.Parallel()
+
+	transactions := []PersonalAccountTransaction{
+		// An old transaction corrected recently.
+		{ID: 1, Date: "2022-12-01T00:00:00Z", UpdatedAt: "2024-03-01T10:00:00Z"},
+		// A recent transaction not updated since the last sync.
+		{ID: 2, Date: "2024-03-01T00:00:00Z", UpdatedAt: "2024-03-01T08:59:59Z"},
+		{ID: 3, Date: "2024-03-01T00:00:00Z", UpdatedAt: "2024-03-01T18:00:00+09:00"},
+		{ID: 4, Date: "2024-03-01T00:00:00Z", UpdatedAt: "invalid"},
+	}
+
+	filtered := FilterTransactionsUpdatedSince(transactions, time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC))
+
+	expectedIDs := []int64{1, 3, 4}
+	if len(filtered) != len(expectedIDs) {
+		t.Fatalf("expected %d transactions, got %d", len(expectedIDs), len(filtered))
+	}
+	for i, id := range expectedIDs {
+		if filtered[i].ID != id {
+			t.Errorf("expected filtered[%d].ID %d, got %d", i, id, filtered[i].ID)
+		}
+	}
+}
+
+func TestFilterTransactionsByCategory(t *testing.T) {
+	t.Parallel()
+
+	transactions := []PersonalAccountTransaction{
+		{ID: 1, CategoryID: 10},
+		{ID: 2, CategoryID: 20},
+		{ID: 3, CategoryID: 30},
+		{ID: 4, CategoryID: 10},
+	}
+
+	tests := []struct {
+		name        string
+		categoryIDs []int64
+		expectedIDs []int64
+	}{
+		{name: "success case: one category", categoryIDs: []int64{10}, expectedIDs: []int64{1, 4}},
+		{name: "success case: several categories", categoryIDs: []int64{30, 10}, expectedIDs: []int64{1, 3, 4}},
+		{name: "success case: no category", categoryIDs: nil, expectedIDs: []int64{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			filtered := FilterTransactionsByCategory(transactions, tt.categoryIDs...)
+			ids := make([]int64, 0, len(filtered))
+			for _, tx := range filtered {
+				ids = append(ids, tx.ID)
+			}
+			if !slices.Equal(ids, tt.expectedIDs) {
+				t.Errorf("expected %v, got %v", tt.expectedIDs, ids)
+			}
+		})
+	}
+}
+
+func TestFilterTransactionsByDateRange(t *testing.T) {
+	t.Parallel()
+
+	transactions := []PersonalAccountTransaction{
+		{ID: 1, Date: "2023-02-28T23:59:59+09:00"},
+		{ID: 2, Date: "2023-03-01T00:00:00+09:00"},
+		{ID: 3, Date: "2023-03-15T12:00:00Z"},
+		{ID: 4, Date: "2023-03-31T23:59:59+09:00"},
+		{ID: 5, Date: "2023-04-01T00:00:00+09:00"},
+	}
+	jst := time.FixedZone("JST", 9*60*60)
+	start := time.Date(2023, time.March, 1, 0, 0, 0, 0, jst)
+	end := start.AddDate(0, 1, 0).Add(-time.Nanosecond)
+
+	t.Run("success case: both bounds are inclusive", func(t *testing.T) {
+		t.Parallel()
+
+		filtered, err := FilterTransactionsByDateRange(transactions, start, end)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		ids := make([]int64, 0, len(filtered))
+		for _, tx := range filtered {
+			ids = append(ids, tx.ID)
+		}
+		if expected := []int64{2, 3, 4}; !slices.Equal(ids, expected) {
+			t.Errorf("expected %v, got %v", expected, ids)
+		}
+	})
+
+	t.Run("error case: start is after end", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := FilterTransactionsByDateRange(transactions, end, start); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+
+	t.Run("error case: a transaction date cannot be parsed", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := FilterTransactionsByDateRange([]PersonalAccountTransaction{{ID: 1, Date: "2023-03-01"}}, start, end)
+		if err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
+
+func TestGetPersonalAccountTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: transactions list is retrieved correctly", func(t *testing.T) {
+		t.Parallel()
+
+		descriptionGuest := "テスト取引"
+		descriptionPretty := "テスト取引（補正済み）"
 		descriptionRaw := "テスト取引（生データ）"
 		categoryEntityKey := "category_key_123"
 
@@ -1662,6 +2254,71 @@ func TestGetPersonalAccountTransactions(t *testing.T) {
 		}
 	})
 
+	t.Run("success case: since boundary is inclusive or exclusive", func(t *testing.T) {
+		t.Parallel()
+
+		response := PersonalAccountTransactions{
+			Transactions: []PersonalAccountTransaction{
+				{ID: 1, UpdatedAt: "2023-01-01T23:30:00+09:00"},
+				{ID: 2, UpdatedAt: "2023-01-02T00:10:00+09:00"},
+				{ID: 3, UpdatedAt: "invalid"},
+			},
+		}
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if since := r.URL.Query().Get("since"); since != "" && since != "2023-01-01" {
+				t.Errorf("expected since parameter 2023-01-01, got %s", since)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			if err := json.NewEncoder(w).Encode(response); err != nil {
+				t.Errorf("failed to encode response: %v", err)
+			}
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+		setTestToken(client, "test-access-token")
+
+		jst := time.FixedZone("JST", 9*60*60)
+		tests := []struct {
+			name string
+			opts []GetPersonalAccountTransactionsOption
+			want []int64
+		}{
+			{name: "default", opts: []GetPersonalAccountTransactionsOption{WithSinceForTransactions("2023-01-01")}, want: []int64{1, 2, 3}},
+			{name: "inclusive", opts: []GetPersonalAccountTransactionsOption{WithSinceForTransactions("2023-01-01"), WithSinceInclusiveForTransactions(true)}, want: []int64{1, 2, 3}},
+			{name: "exclusive", opts: []GetPersonalAccountTransactionsOption{WithSinceForTransactions("2023-01-01"), WithSinceInclusiveForTransactions(false)}, want: []int64{2, 3}},
+			{name: "exclusive without since", opts: []GetPersonalAccountTransactionsOption{WithSinceInclusiveForTransactions(false)}, want: []int64{1, 2, 3}},
+			// Unlike the exclusive since date, a timestamp keeps the edits made later on the same day.
+			{name: "updated since before a same-day edit", opts: []GetPersonalAccountTransactionsOption{WithUpdatedSinceForTransactions(time.Date(2023, 1, 1, 23, 0, 0, 0, jst))}, want: []int64{1, 2, 3}},
+			{name: "updated since after a same-day edit", opts: []GetPersonalAccountTransactionsOption{WithUpdatedSinceForTransactions(time.Date(2023, 1, 1, 23, 45, 0, 0, jst))}, want: []int64{2, 3}},
+		}
+		for _, tt := range tests {
+			got, err := client.GetPersonalAccountTransactions(context.Background(), "account_key_123", tt.opts...)
+			if err != nil {
+				t.Fatalf("%s: expected nil, got %v", tt.name, err)
+			}
+			var ids []int64
+			for _, transaction := range got.Transactions {
+				ids = append(ids, transaction.ID)
+			}
+			if !reflect.DeepEqual(ids, tt.want) {
+				t.Errorf("%s: expected transaction IDs %v, got %v", tt.name, tt.want, ids)
+			}
+		}
+	})
+
 	t.Run("error case: returns error when access token is empty", func(t *testing.T) {
 		t.Parallel()
 
@@ -1815,10 +2472,6 @@ func TestGetPersonalAccountTransactions(t *testing.T) {
 	})
 }
 
-func int64Ptr(i int64) *int64 {
-	return &i
-}
-
 func TestUpdatePersonalAccountTransaction(t *testing.T) {
 	t.Parallel()
 
@@ -1894,7 +2547,7 @@ func TestUpdatePersonalAccountTransaction(t *testing.T) {
 
 		request := &UpdatePersonalAccountTransactionRequest{
 			DescriptionGuest: &descriptionGuest,
-			CategoryID:       int64Ptr(123),
+			CategoryID:       Int64Ptr(123),
 		}
 
 		setTestToken(client, "test-access-token")
@@ -2037,7 +2690,7 @@ func TestUpdatePersonalAccountTransaction(t *testing.T) {
 		}
 
 		request := &UpdatePersonalAccountTransactionRequest{
-			CategoryID: int64Ptr(789),
+			CategoryID: Int64Ptr(789),
 		}
 
 		setTestToken(client, "test-access-token")
@@ -2146,7 +2799,7 @@ func TestUpdatePersonalAccountTransaction(t *testing.T) {
 		}
 
 		request := &UpdatePersonalAccountTransactionRequest{
-			DescriptionGuest: stringPtr("test"),
+			DescriptionGuest: StringPtr("test"),
 		}
 
 		// Token is not set, so refreshToken should fail
@@ -2171,7 +2824,7 @@ func TestUpdatePersonalAccountTransaction(t *testing.T) {
 		}
 
 		request := &UpdatePersonalAccountTransactionRequest{
-			DescriptionGuest: stringPtr("test"),
+			DescriptionGuest: StringPtr("test"),
 		}
 
 		setTestToken(client, "test-token")
@@ -2251,7 +2904,7 @@ func TestUpdatePersonalAccountTransaction(t *testing.T) {
 		}
 
 		request := &UpdatePersonalAccountTransactionRequest{
-			CategoryID: int64Ptr(99999),
+			CategoryID: Int64Ptr(99999),
 		}
 
 		setTestToken(client, "test-token")
@@ -2285,7 +2938,7 @@ func TestUpdatePersonalAccountTransaction(t *testing.T) {
 		}
 
 		request := &UpdatePersonalAccountTransactionRequest{
-			DescriptionGuest: stringPtr("test"),
+			DescriptionGuest: StringPtr("test"),
 		}
 
 		setTestToken(client, "test-token")
@@ -2296,3 +2949,631 @@ func TestUpdatePersonalAccountTransaction(t *testing.T) {
 		}
 	})
 }
+
+func TestGetTransactionsByDateRange(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: transactions within the range are merged across accounts", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+		end := time.Date(2023, time.March, 31, 23, 59, 59, 0, time.UTC)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/link/accounts.json":
+				_, _ = w.Write([]byte(`{"accounts": [{"account_key": "account_a"}, {"account_key": "account_b"}]}`))
+			case "/link/accounts/account_a/transactions.json":
+				if since := r.URL.Query().Get("since"); since != "2023-02-28" {
+					t.Errorf("expected since parameter 2023-02-28, got %s", since)
+				}
+				_, _ = w.Write([]byte(`{"transactions": [
+					{"id": 1, "amount": -100, "date": "2023-02-28T23:59:59Z"},
+					{"id": 2, "amount": -200, "date": "2023-03-01T00:00:00Z"},
+					{"id": 3, "amount": -300, "date": "2023-03-31T23:59:59Z"}
+				]}`))
+			case "/link/accounts/account_b/transactions.json":
+				_, _ = w.Write([]byte(`{"transactions": [
+					{"id": 4, "amount": -400, "date": "2023-03-15T12:00:00+09:00"},
+					{"id": 5, "amount": -500, "date": "2023-04-01T00:00:00Z"}
+				]}`))
+			default:
+				t.Errorf("unexpected path %s", r.URL.Path)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		setTestToken(client, "test-access-token")
+		transactions, err := client.GetTransactionsByDateRange(context.Background(), start, end)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+
+		expectedIDs := []int64{2, 3, 4}
+		if len(transactions) != len(expectedIDs) {
+			t.Fatalf("expected %d transactions, got %d", len(expectedIDs), len(transactions))
+		}
+		for i, expectedID := range expectedIDs {
+			if transactions[i].ID != expectedID {
+				t.Errorf("expected transaction[%d].ID %d, got %d", i, expectedID, transactions[i].ID)
+			}
+		}
+	})
+
+	t.Run("success case: since is the day before start in the client's time zone and invalid dates are reported", func(t *testing.T) {
+		t.Parallel()
+
+		// 2023-03-01 00:00 in JST is still 2023-02-28 in UTC, the time zone of the client's clock.
+		jst := time.FixedZone("JST", 9*60*60)
+		start := time.Date(2023, time.March, 1, 0, 0, 0, 0, jst)
+		end := time.Date(2023, time.March, 31, 23, 59, 59, 0, jst)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/link/accounts.json":
+				_, _ = w.Write([]byte(`{"accounts": [{"account_key": "account_a"}]}`))
+			case "/link/accounts/account_a/transactions.json":
+				if since := r.URL.Query().Get("since"); since != "2023-02-27" {
+					t.Errorf("expected since parameter 2023-02-27, got %s", since)
+				}
+				_, _ = w.Write([]byte(`{"transactions": [
+					{"id": 1, "amount": -100, "date": "invalid"},
+					{"id": 2, "amount": -200, "date": "2023-03-01T00:00:00+09:00"}
+				]}`))
+			default:
+				t.Errorf("unexpected path %s", r.URL.Path)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+			clock: newFakeClock(time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC)),
+		}
+
+		setTestToken(client, "test-access-token")
+		transactions, err := client.GetTransactionsByDateRange(context.Background(), start, end)
+		if err == nil || !strings.Contains(err.Error(), "failed to parse date of transaction 1") {
+			t.Fatalf("expected error for transaction 1, got %v", err)
+		}
+		if transactions != nil {
+			t.Errorf("expected nil transactions with the default error policy, got %v", transactions)
+		}
+
+		transactions, err = client.GetTransactionsByDateRange(context.Background(), start, end, WithErrorPolicy(ErrorPolicyCollect))
+		if err == nil || !strings.Contains(err.Error(), "failed to parse date of transaction 1") {
+			t.Fatalf("expected error for transaction 1, got %v", err)
+		}
+		if len(transactions) != 1 || transactions[0].ID != 2 {
+			t.Errorf("expected only transaction 2, got %v", transactions)
+		}
+	})
+
+	t.Run("success case: repeated runs return the same order whichever account completes first", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+		end := time.Date(2023, time.March, 31, 23, 59, 59, 0, time.UTC)
+
+		const accounts = 5
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Path == "/link/accounts.json" {
+				var keys []string
+				for i := range accounts {
+					keys = append(keys, fmt.Sprintf(`{"account_key": "account_%d"}`, i))
+				}
+				_, _ = w.Write([]byte(`{"accounts": [` + strings.Join(keys, ",") + `]}`))
+				return
+			}
+			var i int
+			if _, err := fmt.Sscanf(r.URL.Path, "/link/accounts/account_%d/transactions.json", &i); err != nil {
+				t.Errorf("unexpected path %s", r.URL.Path)
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			// Responses are delayed at random, so that the accounts complete in a different order on every run.
+			// nolint:gosec // G404: Using math/rand is acceptable for test delays
+			time.Sleep(time.Duration(rand.Intn(20)) * time.Millisecond)
+			_, _ = fmt.Fprintf(w, `{"transactions": [{"id": %d, "date": "2023-03-02T00:00:00Z"}, {"id": %d, "date": "2023-03-01T00:00:00Z"}]}`,
+				i*10+1, i*10)
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+		setTestToken(client, "test-access-token")
+
+		var expectedIDs []int64
+		for i := range accounts {
+			expectedIDs = append(expectedIDs, int64(i*10+1), int64(i*10))
+		}
+		for run := range 5 {
+			transactions, err := client.GetTransactionsByDateRange(context.Background(), start, end, WithConcurrency(accounts))
+			if err != nil {
+				t.Fatalf("run %d: expected nil, got %v", run, err)
+			}
+			var ids []int64
+			for _, transaction := range transactions {
+				ids = append(ids, transaction.ID)
+			}
+			if !slices.Equal(ids, expectedIDs) {
+				t.Fatalf("run %d: expected IDs %v, got %v", run, expectedIDs, ids)
+			}
+		}
+	})
+
+	t.Run("success case: all pages are fetched when a page is full", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+		end := time.Date(2023, time.March, 31, 23, 59, 59, 0, time.UTC)
+
+		var requestedPages []string
+		var mu sync.Mutex
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/link/accounts.json":
+				_, _ = w.Write([]byte(`{"accounts": [{"account_key": "account_a"}]}`))
+			case "/link/accounts/account_a/transactions.json":
+				page := r.URL.Query().Get("page")
+				mu.Lock()
+				requestedPages = append(requestedPages, page)
+				mu.Unlock()
+
+				if perPage := r.URL.Query().Get("per_page"); perPage != "500" {
+					t.Errorf("expected per_page parameter 500, got %s", perPage)
+				}
+
+				// The first page is exactly full, the second page has a single item.
+				count := 500
+				if page == "2" {
+					count = 1
+				}
+				response := PersonalAccountTransactions{}
+				for i := 0; i < count; i++ {
+					response.Transactions = append(response.Transactions, PersonalAccountTransaction{
+						ID:   int64(i + 1),
+						Date: "2023-03-10T00:00:00Z",
+					})
+				}
+				if err := json.NewEncoder(w).Encode(response); err != nil {
+					t.Errorf("failed to encode response: %v", err)
+				}
+			default:
+				t.Errorf("unexpected path %s", r.URL.Path)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		setTestToken(client, "test-access-token")
+		transactions, err := client.GetTransactionsByDateRange(context.Background(), start, end)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+
+		if len(transactions) != 501 {
+			t.Errorf("expected 501 transactions, got %d", len(transactions))
+		}
+		if len(requestedPages) != 2 || requestedPages[0] != "1" || requestedPages[1] != "2" {
+			t.Errorf("expected pages [1 2] to be requested, got %v", requestedPages)
+		}
+	})
+
+	t.Run("error case: returns error when start is after end", func(t *testing.T) {
+		t.Parallel()
+
+		baseURL, err := url.Parse("https://test.getmoneytree.com/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		start := time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC)
+		end := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+		_, err = client.GetTransactionsByDateRange(context.Background(), start, end)
+		if err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+
+	t.Run("error case: returns error when API returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Path == "/link/accounts.json" {
+				_, _ = w.Write([]byte(`{"accounts": [{"account_key": "account_a"}]}`))
+				return
+			}
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error": "forbidden", "error_description": "Insufficient scope"}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+
+		setTestToken(client, "test-access-token")
+		start := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+		end := time.Date(2023, time.March, 31, 0, 0, 0, 0, time.UTC)
+		_, err = client.GetTransactionsByDateRange(context.Background(), start, end)
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected APIError, got %v", err)
+		}
+		if apiErr.StatusCode != http.StatusForbidden {
+			t.Errorf("expected status code %d, got %d", http.StatusForbidden, apiErr.StatusCode)
+		}
+	})
+}
+
+func TestPersonalAccountTransactionAttributes_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: unmodeled members are kept in Extra", func(t *testing.T) {
+		t.Parallel()
+
+		data := `{"attributes": {"expense_type": 2, "merchant": {"name": "Coffee Shop", "logo_url": "https://example.com/logo.png"}}}`
+
+		var transaction PersonalAccountTransaction
+		if err := json.Unmarshal([]byte(data), &transaction); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+
+		attributes := transaction.Attributes
+		if attributes.ExpenseType == nil || *attributes.ExpenseType != 2 {
+			t.Errorf("expected expense_type 2, got %v", attributes.ExpenseType)
+		}
+		if len(attributes.Extra) != 1 {
+			t.Fatalf("expected 1 extra member, got %v", attributes.Extra)
+		}
+
+		var merchant struct {
+			Name    string `json:"name"`
+			LogoURL string `json:"logo_url"`
+		}
+		if err := json.Unmarshal(attributes.Extra["merchant"], &merchant); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if merchant.Name != "Coffee Shop" || merchant.LogoURL != "https://example.com/logo.png" {
+			t.Errorf("unexpected merchant %+v", merchant)
+		}
+	})
+
+	t.Run("success case: Extra is nil when only modeled members are present", func(t *testing.T) {
+		t.Parallel()
+
+		for _, data := range []string{`{"attributes": {"data_source": "bank"}}`, `{"attributes": {}}`, `{"attributes": null}`, `{}`} {
+			var transaction PersonalAccountTransaction
+			if err := json.Unmarshal([]byte(data), &transaction); err != nil {
+				t.Fatalf("%s: expected nil, got %v", data, err)
+			}
+			if transaction.Attributes.Extra != nil {
+				t.Errorf("%s: expected nil Extra, got %v", data, transaction.Attributes.Extra)
+			}
+		}
+	})
+
+	t.Run("error case: invalid modeled member type", func(t *testing.T) {
+		t.Parallel()
+
+		var transaction PersonalAccountTransaction
+		if err := json.Unmarshal([]byte(`{"attributes": {"expense_type": "business"}}`), &transaction); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}
+
+func TestPersonalAccountTransactionAttributes_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: Extra is merged back in and survives a round trip", func(t *testing.T) {
+		t.Parallel()
+
+		data := `{"data_source":"bank","expense_type":2,"merchant":{"name":"Coffee Shop"}}`
+		var attributes PersonalAccountTransactionAttributes
+		if err := json.Unmarshal([]byte(data), &attributes); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+
+		encoded, err := json.Marshal(attributes)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if string(encoded) != data {
+			t.Errorf("expected %s, got %s", data, encoded)
+		}
+	})
+
+	t.Run("success case: a modeled member takes precedence over Extra", func(t *testing.T) {
+		t.Parallel()
+
+		expenseType := 1
+		attributes := PersonalAccountTransactionAttributes{
+			ExpenseType: &expenseType,
+			Extra:       map[string]json.RawMessage{"expense_type": json.RawMessage(`2`)},
+		}
+		encoded, err := json.Marshal(attributes)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if expected := `{"expense_type":1}`; string(encoded) != expected {
+			t.Errorf("expected %s, got %s", expected, encoded)
+		}
+	})
+
+	t.Run("success case: without Extra only the modeled members are encoded", func(t *testing.T) {
+		t.Parallel()
+
+		encoded, err := json.Marshal(PersonalAccountTransactionAttributes{})
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if string(encoded) != "{}" {
+			t.Errorf("expected {}, got %s", encoded)
+		}
+	})
+}
+
+func TestGetTransactionsByDateRange_ErrorPolicy(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2023, time.March, 31, 23, 59, 59, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/link/accounts.json":
+			_, _ = w.Write([]byte(`{"accounts": [{"account_key": "account_a"}, {"account_key": "account_b"}, {"account_key": "account_c"}]}`))
+		case "/link/accounts/account_a/transactions.json":
+			_, _ = w.Write([]byte(`{"transactions": [{"id": 1, "amount": -100, "date": "2023-03-10T00:00:00Z"}]}`))
+		case "/link/accounts/account_b/transactions.json":
+			_, _ = w.Write([]byte(`{"transactions": [
+				{"id": 2, "amount": -200, "date": "2023-03-11T00:00:00Z"},
+				{"id": 3, "amount": -300, "date": "invalid"}
+			]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error": "not_found"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	baseURL, err := url.Parse(server.URL + "/")
+	if err != nil {
+		t.Fatalf("failed to parse base URL: %v", err)
+	}
+
+	client := &Client{
+		httpClient: http.DefaultClient,
+		config: &Config{
+			BaseURL: baseURL,
+		},
+	}
+	setTestToken(client, "test-access-token")
+
+	t.Run("error case: fail fast returns no transactions", func(t *testing.T) {
+		t.Parallel()
+
+		transactions, err := client.GetTransactionsByDateRange(context.Background(), start, end)
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if transactions != nil {
+			t.Errorf("expected nil transactions, got %v", transactions)
+		}
+	})
+
+	t.Run("error case: collect returns the transactions of the accounts that succeeded", func(t *testing.T) {
+		t.Parallel()
+
+		transactions, err := client.GetTransactionsByDateRange(context.Background(), start, end, WithErrorPolicy(ErrorPolicyCollect))
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("expected the 404 of account_c in the errors, got %v", err)
+		}
+		if err == nil || !strings.Contains(err.Error(), "failed to parse date of transaction 3") {
+			t.Errorf("expected the skipped transaction of account_b in the errors, got %v", err)
+		}
+		// Only the transaction of account_b that cannot be parsed is skipped.
+		if len(transactions) != 2 || transactions[0].ID != 1 || transactions[1].ID != 2 {
+			t.Errorf("expected transactions 1 and 2, got %v", transactions)
+		}
+	})
+}
+
+func TestPersonalAccountTransactionsPager(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: pages are requested one at a time with the options", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/link/accounts/account_key_123/transactions.json" {
+				t.Errorf("unexpected path %s", r.URL.Path)
+			}
+			query := r.URL.Query()
+			if query.Get("per_page") != "2" || query.Get("since") != "2023-01-01" {
+				t.Errorf("unexpected query %s", r.URL.RawQuery)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			switch query.Get("page") {
+			case "1":
+				_, _ = w.Write([]byte(`{"transactions": [{"id": 1}, {"id": 2}]}`))
+			case "2":
+				_, _ = w.Write([]byte(`{"transactions": [{"id": 3}, {"id": 4}]}`))
+			case "3":
+				_, _ = w.Write([]byte(`{"transactions": [{"id": 5}]}`))
+			default:
+				t.Errorf("unexpected page %s", query.Get("page"))
+			}
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+		setTestToken(client, "test-access-token")
+
+		pager := client.PersonalAccountTransactionsPager("account_key_123",
+			WithSinceForTransactions("2023-01-01"),
+			WithPerPageForTransactions(2),
+		)
+		var pageSizes []int
+		for pager.HasMore() {
+			transactions, err := pager.Next(context.Background())
+			if err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+			pageSizes = append(pageSizes, len(transactions))
+		}
+		if !reflect.DeepEqual(pageSizes, []int{2, 2, 1}) {
+			t.Errorf("expected page sizes [2 2 1], got %v", pageSizes)
+		}
+	})
+
+	t.Run("error case: invalid options are reported by Next", func(t *testing.T) {
+		t.Parallel()
+
+		client := &Client{config: &Config{}}
+		for _, pager := range []*Pager[PersonalAccountTransaction]{
+			client.PersonalAccountTransactionsPager(""),
+			client.PersonalAccountTransactionsPager("account_key_123", WithSinceForTransactions("2023/01/01")),
+			client.PersonalAccountTransactionsPager("account_key_123", WithPerPageForTransactions(1000)),
+		} {
+			if _, err := pager.Next(context.Background()); err == nil {
+				t.Error("expected error, got nil")
+			}
+			if pager.HasMore() {
+				t.Error("expected HasMore to be false")
+			}
+		}
+	})
+}
+
+func TestValidatePersonalAccountTransactionsOptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: valid options return nil", func(t *testing.T) {
+		t.Parallel()
+
+		err := ValidatePersonalAccountTransactionsOptions(
+			WithSinceForTransactions("2023-01-01"),
+			WithSortByForTransactions("desc"),
+			WithPerPageForTransactions(500),
+		)
+		if err != nil {
+			t.Errorf("expected nil, got %v", err)
+		}
+	})
+
+	t.Run("error case: every invalid option is reported", func(t *testing.T) {
+		t.Parallel()
+
+		opts := []GetPersonalAccountTransactionsOption{
+			WithSinceForTransactions("2023/01/01"),
+			WithSortByForTransactions("newest"),
+			WithPerPageForTransactions(1000),
+		}
+		expected := []string{"2023/01/01", "sort_by", "per_page"}
+
+		err := ValidatePersonalAccountTransactionsOptions(opts...)
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		for _, want := range expected {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("expected error to mention %q, got %v", want, err)
+			}
+		}
+
+		// The API method reports the same errors without sending a request.
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: &url.URL{Scheme: "http", Host: "127.0.0.1:0", Path: "/"},
+			},
+		}
+		setTestToken(client, "test-access-token")
+		_, err = client.GetPersonalAccountTransactions(context.Background(), "account_key_123", opts...)
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		for _, want := range expected {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("expected error to mention %q, got %v", want, err)
+			}
+		}
+	})
+}
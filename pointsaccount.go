@@ -50,7 +50,7 @@ type PointAccount struct {
 	// "auth.creds.invalid", "auth.creds.locked.temporary", "auth.creds.locked.permanent",
 	// "error.permanent", "error.temporary", "error.session", "error.network",
 	// "error.service.unavailable", "error.unsupported", "unknown".
-	AggregationStatus string `json:"aggregation_status"`
+	AggregationStatus AggregationStatus `json:"aggregation_status"`
 	// LastAggregatedAt is the last time data was acquired for this account.
 	// Format: ISO 8601 date-time.
 	LastAggregatedAt string `json:"last_aggregated_at"`
@@ -129,7 +129,9 @@ func (c *Client) GetPointAccounts(ctx context.Context, opts ...GetPointAccountsO
 
 	urlPath := "link/points/accounts.json"
 	queryParams := url.Values{}
-	applyPaginationParams(queryParams, &options.paginationOptions)
+	if err := c.applyPagination(queryParams, &options.paginationOptions); err != nil {
+		return nil, err
+	}
 	if len(queryParams) > 0 {
 		urlPath = fmt.Sprintf("%s?%s", urlPath, queryParams.Encode())
 	}
@@ -182,7 +184,7 @@ func WithPerPageForPointAccountTransactions(perPage int) GetPointAccountTransact
 // Using sort_key may affect response time, so it is recommended to use it only when necessary.
 // If "date" is specified as the sort key, the database sorts by the transaction date
 // (which is the actual transaction date, not the date Moneytree obtained it).
-// The default value is "id".
+// The default value is "id". The possible values are listed by SupportedTransactionSortKeys.
 func WithSortKeyForPointAccountTransactions(sortKey string) GetPointAccountTransactionsOption {
 	return func(opts *getTransactionsOptions) {
 		opts.SortKey = &sortKey
@@ -207,6 +209,17 @@ func WithSinceForPointAccountTransactions(since string) GetPointAccountTransacti
 	}
 }
 
+// ValidatePointAccountTransactionsOptions validates opts as GetPointAccountTransactions does,
+// without sending a request. Like ValidatePersonalAccountTransactionsOptions, it reports every
+// invalid option at once in an error joined with errors.Join.
+func ValidatePointAccountTransactionsOptions(opts ...GetPointAccountTransactionsOption) error {
+	options := &getTransactionsOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return options.validate()
+}
+
 // GetPointAccountTransactions retrieves the transaction records for a specific point account.
 // This endpoint requires the points_read OAuth scope.
 //
@@ -247,21 +260,21 @@ func (c *Client) GetPointAccountTransactions(ctx context.Context, accountID int6
 		opt(options)
 	}
 
-	if options.Since != nil {
-		if err := validateDateFormat(*options.Since); err != nil {
+	if !c.skipClientValidation() {
+		if err := options.validate(); err != nil {
 			return nil, err
 		}
-	}
 
-	if options.SortBy != nil {
-		if *options.SortBy != "asc" && *options.SortBy != "desc" {
-			return nil, fmt.Errorf("sort_by must be 'asc' or 'desc', got: %s", *options.SortBy)
+		if err := c.validateSinceNotInFuture(options.Since); err != nil {
+			return nil, err
 		}
 	}
 
 	urlPath := fmt.Sprintf("link/points/accounts/%d/transactions.json", accountID)
 	queryParams := url.Values{}
-	applyPaginationParams(queryParams, &options.paginationOptions)
+	if err := c.applyPagination(queryParams, &options.paginationOptions); err != nil {
+		return nil, err
+	}
 	if options.SortKey != nil {
 		queryParams.Set("sort_key", *options.SortKey)
 	}
@@ -382,15 +395,23 @@ func (c *Client) GetPointExpirations(ctx context.Context, accountID int64, opts
 		opt(options)
 	}
 
-	if options.Since != nil {
-		if err := validateDateFormat(*options.Since); err != nil {
+	if !c.skipClientValidation() {
+		if options.Since != nil {
+			if err := validateDateFormat(*options.Since); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := c.validateSinceNotInFuture(options.Since); err != nil {
 			return nil, err
 		}
 	}
 
 	urlPath := fmt.Sprintf("link/points/accounts/%d/expirations.json", accountID)
 	queryParams := url.Values{}
-	applyPaginationParams(queryParams, &options.paginationOptions)
+	if err := c.applyPagination(queryParams, &options.paginationOptions); err != nil {
+		return nil, err
+	}
 	if options.Since != nil {
 		queryParams.Set("since", *options.Since)
 	}
@@ -19,8 +19,8 @@ func TestGetPointAccounts(t *testing.T) {
 
 		id1 := int64(123)
 		id2 := int64(456)
-		balance1 := float64Ptr(10000.50)
-		balance2 := float64Ptr(5000.00)
+		balance1 := Float64Ptr(10000.50)
+		balance2 := Float64Ptr(5000.00)
 		lastAggregatedAt := "2023-01-01T00:00:00Z"
 		lastAggregatedSuccess := "2023-01-01T00:00:00Z"
 		createdAt := "2023-01-01T00:00:00Z"
@@ -40,7 +40,7 @@ func TestGetPointAccounts(t *testing.T) {
 					AggregationState:       "success",
 					AggregationStatus:      "success",
 					LastAggregatedAt:       lastAggregatedAt,
-					LastAggregatedSuccess:  stringPtr(lastAggregatedSuccess),
+					LastAggregatedSuccess:  StringPtr(lastAggregatedSuccess),
 					CreatedAt:              createdAt,
 					UpdatedAt:              updatedAt,
 				},
@@ -56,7 +56,7 @@ func TestGetPointAccounts(t *testing.T) {
 					AggregationState:       "success",
 					AggregationStatus:      "success",
 					LastAggregatedAt:       lastAggregatedAt,
-					LastAggregatedSuccess:  stringPtr(lastAggregatedSuccess),
+					LastAggregatedSuccess:  StringPtr(lastAggregatedSuccess),
 					CreatedAt:              createdAt,
 					UpdatedAt:              updatedAt,
 				},
@@ -37,6 +37,39 @@ func (c *Client) GetProfile(ctx context.Context) (*Profile, error) {
 	return &profile, nil
 }
 
+// Ping verifies that the current access token is still accepted by the API.
+// It sends a lightweight authenticated request to the profile endpoint and discards the response body.
+// This endpoint requires the guest_read OAuth scope.
+//
+// Ping returns nil if the API responds with a 2xx status. If the token has been
+// rejected, it returns an *APIError for which IsUnauthorized reports true.
+//
+// Example:
+//
+//	if err := client.Ping(ctx); err != nil {
+//		var apiErr *moneytree.APIError
+//		if errors.As(err, &apiErr) && apiErr.IsUnauthorized() {
+//			// Ask the user to log in again before starting the sync.
+//		}
+//		log.Fatal(err)
+//	}
+func (c *Client) Ping(ctx context.Context) error {
+	httpReq, err := c.NewRequest(ctx, http.MethodGet, "link/profile.json", nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.Do(ctx, httpReq, nil)
+	if err != nil {
+		return err
+	}
+	// Do does not report 1xx and 3xx responses as errors, but a ping must only succeed on 2xx.
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return &APIError{StatusCode: resp.StatusCode, RequestID: resp.Header.Get(RequestIDHeader), Header: resp.Header.Clone()}
+	}
+	return nil
+}
+
 // RevokeProfile revokes the guest account connection.
 // This endpoint requires the guest_read OAuth scope.
 func (c *Client) RevokeProfile(ctx context.Context) error {
@@ -60,7 +93,7 @@ type AccountGroup struct {
 	AggregationState string `json:"aggregation_state"`
 	// AggregationStatus represents the current data acquisition status in more detail than AggregationState.
 	// For a list of possible values and their meanings, refer to the aggregation_status list guide.
-	AggregationStatus string `json:"aggregation_status"`
+	AggregationStatus AggregationStatus `json:"aggregation_status"`
 	// LastAggregatedAt is the last time data was acquired.
 	// Format: "2006-01-02" (YYYY-MM-DD).
 	LastAggregatedAt string `json:"last_aggregated_at"`
@@ -157,6 +157,117 @@ func TestGetProfile(t *testing.T) {
 	})
 }
 
+func TestPing(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: returns nil when the token is live", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				t.Errorf("expected method %s, got %s", http.MethodGet, r.Method)
+			}
+			if r.URL.Path != "/link/profile.json" {
+				t.Errorf("expected path /link/profile.json, got %s", r.URL.Path)
+			}
+			expectedAuthHeader := "Bearer test-access-token"
+			if authHeader := r.Header.Get("Authorization"); authHeader != expectedAuthHeader {
+				t.Errorf("expected Authorization header %s, got %s", expectedAuthHeader, authHeader)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"locale_identifier": "ja_JP", "email": "user@example.com", "moneytree_id": "1234567890"}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+		setTestToken(client, "test-access-token")
+
+		if err := client.Ping(context.Background()); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+	})
+
+	t.Run("error case: returns unauthorized APIError when the token is expired", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error": "invalid_token", "error_description": "The access token expired"}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+		setTestToken(client, "expired-token")
+
+		err = client.Ping(context.Background())
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected APIError, got %T", err)
+		}
+		if !apiErr.IsUnauthorized() {
+			t.Errorf("expected IsUnauthorized to be true, got status code %d", apiErr.StatusCode)
+		}
+	})
+
+	t.Run("error case: returns APIError when the API responds with a server error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+		setTestToken(client, "test-access-token")
+
+		err = client.Ping(context.Background())
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected APIError, got %T", err)
+		}
+		if apiErr.StatusCode != http.StatusServiceUnavailable {
+			t.Errorf("expected status code %d, got %d", http.StatusServiceUnavailable, apiErr.StatusCode)
+		}
+	})
+}
+
 func TestRevokeProfile(t *testing.T) {
 	t.Parallel()
 
@@ -296,7 +407,7 @@ func TestGetAccountGroups(t *testing.T) {
 					AggregationState:      "success",
 					AggregationStatus:     "success",
 					LastAggregatedAt:      lastAggregatedAt,
-					LastAggregatedSuccess: stringPtr(lastAggregatedSuccess),
+					LastAggregatedSuccess: StringPtr(lastAggregatedSuccess),
 					ID:                    &id,
 					AccountGroup:          accountGroupID,
 					InstitutionEntityKey:  "test_institution_key",
@@ -835,7 +946,3 @@ func TestRefreshAccountGroup(t *testing.T) {
 		}
 	})
 }
-
-func stringPtr(s string) *string {
-	return &s
-}
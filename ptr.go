@@ -0,0 +1,41 @@
+package moneytree
+
+// Ptr returns a pointer to a copy of v. Use it to set the optional pointer fields of
+// request structs, including those of custom types, without declaring a variable first.
+//
+// Example:
+//
+//	req := &moneytree.UpdatePersonalAccountTransactionRequest{
+//		DescriptionGuest: moneytree.Ptr("Lunch with the team"),
+//		CategoryID:       moneytree.Ptr(int64(123)),
+//	}
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// StringPtr returns a pointer to a copy of v. It is a shorthand for Ptr[string].
+func StringPtr(v string) *string {
+	return Ptr(v)
+}
+
+// IntPtr returns a pointer to a copy of v. It is a shorthand for Ptr[int].
+func IntPtr(v int) *int {
+	return Ptr(v)
+}
+
+// Int64Ptr returns a pointer to a copy of v. It is a shorthand for Ptr[int64],
+// which spares the conversion of untyped constants, e.g. Int64Ptr(123) for a category ID.
+func Int64Ptr(v int64) *int64 {
+	return Ptr(v)
+}
+
+// Float64Ptr returns a pointer to a copy of v. It is a shorthand for Ptr[float64],
+// which spares the conversion of untyped constants, e.g. Float64Ptr(1000) for an amount.
+func Float64Ptr(v float64) *float64 {
+	return Ptr(v)
+}
+
+// BoolPtr returns a pointer to a copy of v. It is a shorthand for Ptr[bool].
+func BoolPtr(v bool) *bool {
+	return Ptr(v)
+}
@@ -0,0 +1,56 @@
+package moneytree
+
+import "testing"
+
+func TestPtr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: typed helpers return pointers to copies of the values", func(t *testing.T) {
+		t.Parallel()
+
+		if got := StringPtr("memo"); got == nil || *got != "memo" {
+			t.Errorf("expected pointer to memo, got %v", got)
+		}
+		if got := IntPtr(2); got == nil || *got != 2 {
+			t.Errorf("expected pointer to 2, got %v", got)
+		}
+		if got := Int64Ptr(123); got == nil || *got != 123 {
+			t.Errorf("expected pointer to 123, got %v", got)
+		}
+		if got := Float64Ptr(1000.5); got == nil || *got != 1000.5 {
+			t.Errorf("expected pointer to 1000.5, got %v", got)
+		}
+		if got := BoolPtr(true); got == nil || !*got {
+			t.Errorf("expected pointer to true, got %v", got)
+		}
+	})
+
+	t.Run("success case: the pointer does not alias the argument", func(t *testing.T) {
+		t.Parallel()
+
+		value := "before"
+		got := Ptr(value)
+		value = "after"
+		if *got != "before" {
+			t.Errorf("expected before, got %s", *got)
+		}
+		if Ptr(1) == Ptr(1) {
+			t.Error("expected each call to return a new pointer")
+		}
+	})
+
+	t.Run("success case: Ptr works for custom types", func(t *testing.T) {
+		t.Parallel()
+
+		type sortOrder string
+		got := Ptr(sortOrder("desc"))
+		if *got != sortOrder("desc") {
+			t.Errorf("expected desc, got %s", *got)
+		}
+
+		request := Ptr(UpdatePersonalAccountTransactionRequest{CategoryID: Int64Ptr(123)})
+		if request.CategoryID == nil || *request.CategoryID != 123 {
+			t.Errorf("expected category ID 123, got %v", request.CategoryID)
+		}
+	})
+}
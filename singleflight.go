@@ -0,0 +1,155 @@
+package moneytree
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// WithSingleFlight enables coalescing of identical concurrent GET requests.
+// While a GET request is in flight, other GET requests with the same URL, Authorization header
+// and call options (see WithCallOptions) wait for it and share its response instead of sending
+// their own, so many goroutines reading the same categories or accounts cause a single round-trip.
+//
+// Each caller gets its own copy of the *http.Response, whose Header may be modified and whose Body
+// reads the shared body, and decodes it into its own value. An error, including an *APIError,
+// is shared by all callers of the request and must not be modified.
+//
+// The shared request runs with a context derived from the caller that started it, bounded by
+// Config.RequestTimeout, which is canceled when every waiting caller has given up. A caller whose
+// context is canceled or reaches its deadline returns its context's error without affecting the others.
+// Requests other than GET, and GET requests with a body, are never coalesced.
+//
+// Example:
+//
+//	client, err := moneytree.NewClient("jp-api-staging",
+//		moneytree.WithSingleFlight(),
+//	)
+func WithSingleFlight() NewClientOption {
+	return func(c *Client) {
+		c.flights = &flightGroup{}
+	}
+}
+
+// flightGroup tracks the in-flight shared requests of a Client, keyed by flightKey.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+// flightCall is a shared request and, once done is closed, its result.
+type flightCall struct {
+	done chan struct{}
+	// waiters is the number of callers still waiting for the result.
+	waiters int
+	cancel  context.CancelFunc
+
+	resp *http.Response
+	body []byte
+	err  error
+}
+
+// flightKey returns the key under which identical requests are coalesced.
+// The Authorization header is part of the key so that guests never share responses,
+// and so are the call options that change how the request is sent, as the shared
+// request is sent with the call options of the caller that started it.
+func flightKey(req *http.Request, opts callOptions) string {
+	key := req.Method + " " + req.URL.String() + " " + req.Header.Get("Authorization")
+	if opts.noAuth {
+		key += " no-auth"
+	}
+	if opts.maxRetries != nil {
+		key += " max-retries=" + strconv.Itoa(*opts.maxRetries)
+	}
+	return key
+}
+
+// do calls fn once for all concurrent callers with the same key and returns its result.
+// fn runs with a context that is canceled once it returns, once every caller has left,
+// or once timeout has elapsed if it is positive.
+func (g *flightGroup) do(ctx context.Context, key string, timeout time.Duration, fn func(ctx context.Context) (*http.Response, []byte, error)) (*http.Response, []byte, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*flightCall)
+	}
+	call, ok := g.calls[key]
+	if !ok {
+		// The shared request outlives the caller that started it, but not the client's time limit.
+		var sharedCtx context.Context
+		var cancel context.CancelFunc
+		if timeout > 0 {
+			sharedCtx, cancel = context.WithTimeout(context.WithoutCancel(ctx), timeout)
+		} else {
+			sharedCtx, cancel = context.WithCancel(context.WithoutCancel(ctx))
+		}
+		call = &flightCall{done: make(chan struct{}), cancel: cancel}
+		g.calls[key] = call
+		go func() {
+			call.resp, call.body, call.err = fn(sharedCtx)
+			g.forget(key, call)
+			cancel()
+			close(call.done)
+		}()
+	}
+	call.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.resp, call.body, call.err
+	case <-ctx.Done():
+		g.mu.Lock()
+		call.waiters--
+		abandoned := call.waiters == 0
+		if abandoned && g.calls[key] == call {
+			// Nobody is interested in the result anymore; later callers start a new request.
+			delete(g.calls, key)
+		}
+		g.mu.Unlock()
+		if abandoned {
+			call.cancel()
+		}
+		return nil, nil, ctx.Err()
+	}
+}
+
+// forget removes call from the group unless a newer call has replaced it.
+func (g *flightGroup) forget(key string, call *flightCall) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.calls[key] == call {
+		delete(g.calls, key)
+	}
+}
+
+// sendShared sends req through the Client's flightGroup and decodes the shared response body into v.
+func (c *Client) sendShared(ctx context.Context, req *http.Request, usesClientToken bool, v any) (*http.Response, error) {
+	key := flightKey(req, callOptionsFromContext(ctx))
+	resp, body, err := c.flights.do(ctx, key, c.config.RequestTimeout, func(ctx context.Context) (*http.Response, []byte, error) {
+		var buf bytes.Buffer
+		resp, err := c.send(ctx, req.WithContext(ctx), nil, usesClientToken, &buf)
+		return resp, buf.Bytes(), err
+	})
+	resp = copyResponse(resp, body)
+	if err != nil {
+		return resp, err
+	}
+	return resp, decodeResponseBody(resp, bytes.NewReader(body), v, req.URL.Path)
+}
+
+// copyResponse returns a copy of the shared response resp for a single caller, with its own Header
+// and Trailer and a Body that reads body, so that the callers sharing a response do not interfere.
+func copyResponse(resp *http.Response, body []byte) *http.Response {
+	if resp == nil {
+		return nil
+	}
+	copied := *resp
+	copied.Header = resp.Header.Clone()
+	copied.Trailer = resp.Trailer.Clone()
+	copied.Body = io.NopCloser(bytes.NewReader(body))
+	return &copied
+}
@@ -0,0 +1,319 @@
+package moneytree
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// waitForWaiters blocks until n callers are waiting for the in-flight request with key.
+func waitForWaiters(t *testing.T, g *flightGroup, key string, n int) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		g.mu.Lock()
+		call, ok := g.calls[key]
+		waiters := 0
+		if ok {
+			waiters = call.waiters
+		}
+		g.mu.Unlock()
+		if waiters == n {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %d waiters", n)
+}
+
+func TestWithSingleFlight(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: concurrent identical GETs share one round-trip", func(t *testing.T) {
+		t.Parallel()
+
+		var hits atomic.Int32
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			hits.Add(1)
+			<-release
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"email": "test@example.com"}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+			flights: &flightGroup{},
+		}
+		setTestToken(client, "test-access-token")
+
+		const callers = 20
+		var wg sync.WaitGroup
+		profiles := make([]*Profile, callers)
+		errs := make([]error, callers)
+		for i := range callers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				profiles[i], errs[i] = client.GetProfile(context.Background())
+			}()
+		}
+
+		key := "GET " + server.URL + "/link/profile.json Bearer test-access-token"
+		waitForWaiters(t, client.flights, key, callers)
+		close(release)
+		wg.Wait()
+
+		if got := hits.Load(); got != 1 {
+			t.Errorf("expected 1 server hit, got %d", got)
+		}
+		for i := range callers {
+			if errs[i] != nil {
+				t.Fatalf("caller %d: expected nil, got %v", i, errs[i])
+			}
+			if profiles[i].Email != "test@example.com" {
+				t.Errorf("caller %d: expected email test@example.com, got %s", i, profiles[i].Email)
+			}
+		}
+		if profiles[0] == profiles[1] {
+			t.Error("expected each caller to decode into its own value")
+		}
+	})
+
+	t.Run("success case: a canceled caller does not cancel the shared request", func(t *testing.T) {
+		t.Parallel()
+
+		var hits atomic.Int32
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			hits.Add(1)
+			<-release
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"email": "test@example.com"}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+			flights: &flightGroup{},
+		}
+		setTestToken(client, "test-access-token")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		firstErr := make(chan error, 1)
+		go func() {
+			_, err := client.GetProfile(ctx)
+			firstErr <- err
+		}()
+
+		key := "GET " + server.URL + "/link/profile.json Bearer test-access-token"
+		waitForWaiters(t, client.flights, key, 1)
+
+		secondDone := make(chan error, 1)
+		go func() {
+			_, err := client.GetProfile(context.Background())
+			secondDone <- err
+		}()
+		waitForWaiters(t, client.flights, key, 2)
+
+		cancel()
+		if err := <-firstErr; !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+
+		close(release)
+		if err := <-secondDone; err != nil {
+			t.Errorf("expected nil, got %v", err)
+		}
+		if got := hits.Load(); got != 1 {
+			t.Errorf("expected 1 server hit, got %d", got)
+		}
+	})
+
+	t.Run("success case: requests with different tokens are not coalesced", func(t *testing.T) {
+		t.Parallel()
+
+		var hits atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			hits.Add(1)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"email": "` + r.Header.Get("Authorization") + `"}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+			flights: &flightGroup{},
+		}
+
+		for _, token := range []string{"token-a", "token-b"} {
+			profile, err := client.GetProfile(WithCallOptions(context.Background(), WithAccessToken(token)))
+			if err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+			if profile.Email != "Bearer "+token {
+				t.Errorf("expected response for %s, got %s", token, profile.Email)
+			}
+		}
+		if got := hits.Load(); got != 2 {
+			t.Errorf("expected 2 server hits, got %d", got)
+		}
+	})
+
+	t.Run("success case: each caller gets its own copy of the shared response", func(t *testing.T) {
+		t.Parallel()
+
+		var hits atomic.Int32
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			hits.Add(1)
+			<-release
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"email": "test@example.com"}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+			flights: &flightGroup{},
+		}
+		setTestToken(client, "test-access-token")
+
+		const callers = 2
+		var wg sync.WaitGroup
+		resps := make([]*http.Response, callers)
+		errs := make([]error, callers)
+		for i := range callers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				req, err := client.NewRequest(context.Background(), http.MethodGet, "link/profile.json", nil)
+				if err != nil {
+					errs[i] = err
+					return
+				}
+				var profile Profile
+				resps[i], errs[i] = client.Do(context.Background(), req, &profile)
+			}()
+		}
+
+		key := "GET " + server.URL + "/link/profile.json Bearer test-access-token"
+		waitForWaiters(t, client.flights, key, callers)
+		close(release)
+		wg.Wait()
+
+		for i := range callers {
+			if errs[i] != nil {
+				t.Fatalf("caller %d: expected nil, got %v", i, errs[i])
+			}
+		}
+		if got := hits.Load(); got != 1 {
+			t.Errorf("expected 1 server hit, got %d", got)
+		}
+		if resps[0] == resps[1] {
+			t.Fatal("expected each caller to get its own response")
+		}
+		resps[0].Header.Set("Content-Type", "text/plain")
+		if got := resps[1].Header.Get("Content-Type"); got != "application/json" {
+			t.Errorf("expected the other caller's header to be untouched, got %s", got)
+		}
+		for i := range callers {
+			body, err := io.ReadAll(resps[i].Body)
+			if err != nil {
+				t.Fatalf("caller %d: expected nil, got %v", i, err)
+			}
+			if string(body) != `{"email": "test@example.com"}` {
+				t.Errorf("caller %d: expected the shared body, got %s", i, body)
+			}
+		}
+	})
+
+	t.Run("success case: requests with different call options are not coalesced", func(t *testing.T) {
+		t.Parallel()
+
+		req, err := http.NewRequest(http.MethodGet, "https://test.getmoneytree.com/link/profile.json", nil)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		req.Header.Set("Authorization", "Bearer test-access-token")
+
+		noRetries, moreRetries := 0, 5
+		keys := map[string]bool{}
+		for _, opts := range []callOptions{{}, {noAuth: true}, {maxRetries: &noRetries}, {maxRetries: &moreRetries}} {
+			keys[flightKey(req, opts)] = true
+		}
+		if len(keys) != 4 {
+			t.Errorf("expected 4 distinct keys, got %v", keys)
+		}
+		if got := flightKey(req, callOptions{}); got != "GET https://test.getmoneytree.com/link/profile.json Bearer test-access-token" {
+			t.Errorf("unexpected key without call options: %s", got)
+		}
+	})
+
+	t.Run("error case: the shared request is bounded by the timeout", func(t *testing.T) {
+		t.Parallel()
+
+		g := &flightGroup{}
+		// The caller's context has no deadline, so only the timeout can end the shared request.
+		_, _, err := g.do(context.Background(), "key", 10*time.Millisecond, func(ctx context.Context) (*http.Response, []byte, error) {
+			<-ctx.Done()
+			return nil, nil, ctx.Err()
+		})
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected context.DeadlineExceeded, got %v", err)
+		}
+	})
+
+	t.Run("success case: NewClient enables single-flight", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewClient("jp-api-staging", WithSingleFlight())
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if client.flights == nil {
+			t.Error("expected single-flight to be enabled")
+		}
+	})
+}
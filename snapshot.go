@@ -0,0 +1,187 @@
+package moneytree
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+)
+
+// defaultSnapshotTransactionDays is the number of days of transactions ExportSnapshot includes by default.
+const defaultSnapshotTransactionDays = 90
+
+// ExportSnapshotOption configures options for ExportSnapshot.
+type ExportSnapshotOption func(*exportSnapshotOptions)
+
+type exportSnapshotOptions struct {
+	Since *string
+}
+
+// WithSinceForSnapshot specifies that ExportSnapshot includes the transactions updated since this date.
+// The default is 90 days before the export.
+// Date format: "2006-01-02" (YYYY-MM-DD).
+func WithSinceForSnapshot(since string) ExportSnapshotOption {
+	return func(opts *exportSnapshotOptions) {
+		opts.Since = &since
+	}
+}
+
+// ExportSnapshot writes a snapshot of the guest's personal accounts to w as a single JSON document,
+// for backups or to attach to a support request.
+// This requires the accounts_read and transactions_read OAuth scopes.
+//
+// The document is an object with the following members, in this order:
+//
+//   - "exported_at": the time of the export in RFC 3339 format.
+//   - "transactions_since": the since date used to select the transactions.
+//   - "accounts": every personal account, as returned by GetPersonalAccounts.
+//   - "categories": every category available to the guest, as returned by GetCategories.
+//   - "balances": the latest balance record of each account, keyed by account key,
+//     or null if the account has no balance record.
+//   - "transactions": the transactions updated since WithSinceForSnapshot, 90 days before
+//     the export by default, keyed by account key.
+//
+// The accounts and categories are fetched first; the balances and transactions are then
+// fetched one account at a time and written as they arrive, so memory use does not grow with
+// the number of transactions. If a request or a write fails, the error is returned and the
+// document written so far is incomplete.
+//
+// The document contains the guest's financial data as is, including account numbers and
+// transaction descriptions: nothing is redacted. Store and transmit it accordingly.
+//
+// Example:
+//
+//	f, err := os.Create("snapshot.json")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer f.Close()
+//	if err := client.ExportSnapshot(ctx, f); err != nil {
+//		log.Fatal(err)
+//	}
+func (c *Client) ExportSnapshot(ctx context.Context, w io.Writer, opts ...ExportSnapshotOption) error {
+	options := &exportSnapshotOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	now := c.getClock().Now()
+	since := now.AddDate(0, 0, -defaultSnapshotTransactionDays).Format(time.DateOnly)
+	if options.Since != nil {
+		if !c.skipClientValidation() {
+			if err := validateDateFormat(*options.Since); err != nil {
+				return err
+			}
+			if err := c.validateSinceNotInFuture(options.Since); err != nil {
+				return err
+			}
+		}
+		since = *options.Since
+	}
+
+	accounts, err := fetchAllPages(ctx, c.paginationTimeout, "link/accounts.json", url.Values{}, maxPerPage,
+		listPageFetcher(c, func(res *PersonalAccounts) []PersonalAccount { return res.Accounts }))
+	if err != nil {
+		return fmt.Errorf("failed to get personal accounts: %w", err)
+	}
+	categories, err := fetchAllPages(ctx, c.paginationTimeout, "link/categories.json", url.Values{}, maxPerPage,
+		listPageFetcher(c, func(res *Categories) []Category { return res.Categories }))
+	if err != nil {
+		return fmt.Errorf("failed to get categories: %w", err)
+	}
+
+	sw := &snapshotWriter{w: w}
+	sw.raw(`{"exported_at":`)
+	sw.value(now.Format(time.RFC3339))
+	sw.raw(`,"transactions_since":`)
+	sw.value(since)
+	sw.raw(`,"accounts":`)
+	sw.value(accounts)
+	sw.raw(`,"categories":`)
+	sw.value(categories)
+
+	sw.raw(`,"balances":{`)
+	for i, account := range accounts {
+		if sw.err != nil {
+			return sw.err
+		}
+		urlPath := fmt.Sprintf("link/accounts/%s/balances.json", escapePathSegment(account.AccountKey))
+		balances, err := fetchAllPages(ctx, c.paginationTimeout, urlPath, url.Values{}, maxPerPage,
+			listPageFetcher(c, func(res *PersonalAccountBalances) []PersonalAccountBalance { return res.AccountBalances }))
+		if err != nil {
+			return fmt.Errorf("failed to get balances for account %s: %w", account.AccountKey, err)
+		}
+
+		var latest *PersonalAccountBalance
+		if j := latestBalance(balances, func(b PersonalAccountBalance) (string, int64) { return b.Date, b.ID }, nil); j >= 0 {
+			latest = &balances[j]
+		}
+		if i > 0 {
+			sw.raw(",")
+		}
+		sw.value(account.AccountKey)
+		sw.raw(":")
+		sw.value(latest)
+	}
+
+	sw.raw(`},"transactions":{`)
+	queryParams := url.Values{}
+	queryParams.Set("since", since)
+	for i, account := range accounts {
+		if sw.err != nil {
+			return sw.err
+		}
+		urlPath := fmt.Sprintf("link/accounts/%s/transactions.json", escapePathSegment(account.AccountKey))
+		transactions, err := fetchAllPages(ctx, c.paginationTimeout, urlPath, queryParams, maxPerPage,
+			listPageFetcher(c, func(res *PersonalAccountTransactions) []PersonalAccountTransaction { return res.Transactions }))
+		if err != nil {
+			return fmt.Errorf("failed to get transactions for account %s: %w", account.AccountKey, err)
+		}
+		if i > 0 {
+			sw.raw(",")
+		}
+		sw.value(account.AccountKey)
+		sw.raw(":[")
+		for j, transaction := range transactions {
+			if j > 0 {
+				sw.raw(",")
+			}
+			sw.value(transaction)
+		}
+		sw.raw("]")
+	}
+	sw.raw("}}\n")
+	return sw.err
+}
+
+// snapshotWriter writes a JSON document to w piece by piece.
+// Once a write fails, the following writes are skipped and err holds the first error.
+type snapshotWriter struct {
+	w   io.Writer
+	err error
+}
+
+// raw writes str as is.
+func (s *snapshotWriter) raw(str string) {
+	if s.err != nil {
+		return
+	}
+	if _, err := io.WriteString(s.w, str); err != nil {
+		s.err = fmt.Errorf("failed to write snapshot: %w", err)
+	}
+}
+
+// value writes the JSON encoding of v.
+func (s *snapshotWriter) value(v any) {
+	if s.err != nil {
+		return
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		s.err = fmt.Errorf("failed to encode snapshot: %w", err)
+		return
+	}
+	s.raw(string(data))
+}
@@ -0,0 +1,139 @@
+package moneytree
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestExportSnapshot(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: the document contains every section", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Date(2023, time.April, 1, 9, 0, 0, 0, time.UTC)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/link/accounts.json":
+				_, _ = w.Write([]byte(`{"accounts": [{"account_key": "account_a"}, {"account_key": "account_b"}]}`))
+			case "/link/categories.json":
+				_, _ = w.Write([]byte(`{"categories": [{"id": 1, "name": "食費", "is_system": true}]}`))
+			case "/link/accounts/account_a/balances.json":
+				_, _ = w.Write([]byte(`{"account_balances": [
+					{"id": 2, "date": "2023-03-31", "balance": 2000},
+					{"id": 1, "date": "2023-03-30", "balance": 1000}
+				]}`))
+			case "/link/accounts/account_b/balances.json":
+				_, _ = w.Write([]byte(`{"account_balances": []}`))
+			case "/link/accounts/account_a/transactions.json", "/link/accounts/account_b/transactions.json":
+				if since := r.URL.Query().Get("since"); since != "2023-01-01" {
+					t.Errorf("expected since 2023-01-01, got %s", since)
+				}
+				if r.URL.Path == "/link/accounts/account_a/transactions.json" {
+					_, _ = w.Write([]byte(`{"transactions": [{"id": 10}, {"id": 11}]}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"transactions": [{"id": 20}]}`))
+			default:
+				t.Errorf("unexpected path %s", r.URL.Path)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+			clock: newFakeClock(now),
+		}
+		setTestToken(client, "test-access-token")
+
+		var buf bytes.Buffer
+		if err := client.ExportSnapshot(context.Background(), &buf); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+
+		var document struct {
+			ExportedAt        string                                  `json:"exported_at"`
+			TransactionsSince string                                  `json:"transactions_since"`
+			Accounts          []PersonalAccount                       `json:"accounts"`
+			Categories        []Category                              `json:"categories"`
+			Balances          map[string]*PersonalAccountBalance      `json:"balances"`
+			Transactions      map[string][]PersonalAccountTransaction `json:"transactions"`
+		}
+		if err := json.Unmarshal(buf.Bytes(), &document); err != nil {
+			t.Fatalf("failed to decode snapshot: %v\n%s", err, buf.String())
+		}
+
+		if document.ExportedAt != "2023-04-01T09:00:00Z" {
+			t.Errorf("expected exported_at 2023-04-01T09:00:00Z, got %s", document.ExportedAt)
+		}
+		if document.TransactionsSince != "2023-01-01" {
+			t.Errorf("expected transactions_since 2023-01-01, got %s", document.TransactionsSince)
+		}
+		if len(document.Accounts) != 2 {
+			t.Errorf("expected 2 accounts, got %d", len(document.Accounts))
+		}
+		if len(document.Categories) != 1 {
+			t.Errorf("expected 1 category, got %d", len(document.Categories))
+		}
+		if balance := document.Balances["account_a"]; balance == nil || balance.ID != 2 {
+			t.Errorf("expected the latest balance 2 for account_a, got %+v", balance)
+		}
+		if balance, ok := document.Balances["account_b"]; !ok || balance != nil {
+			t.Errorf("expected a null balance for account_b, got %+v", balance)
+		}
+		if transactions := document.Transactions["account_a"]; len(transactions) != 2 || transactions[0].ID != 10 || transactions[1].ID != 11 {
+			t.Errorf("expected transactions 10 and 11 for account_a, got %+v", transactions)
+		}
+		if transactions := document.Transactions["account_b"]; len(transactions) != 1 || transactions[0].ID != 20 {
+			t.Errorf("expected transaction 20 for account_b, got %+v", transactions)
+		}
+	})
+
+	t.Run("error case: returns error when a request fails", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error": "insufficient_scope"}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+		}
+		setTestToken(client, "test-access-token")
+
+		var buf bytes.Buffer
+		err = client.ExportSnapshot(context.Background(), &buf)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Errorf("expected APIError, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package moneytree
+
+// SpendingByCategory sums the spending of the transactions per CategoryID.
+// Only expenses, i.e. transactions with a negative Amount, are included, and their absolute
+// amounts are summed, so the result holds positive values. Income and zero amounts are skipped.
+// Amounts are summed as is, so the transactions should share a currency; filter them by period
+// beforehand, e.g. with GetTransactionsByDateRange, to report the spending of a period.
+//
+// Example:
+//
+//	transactions, err := client.GetTransactionsByDateRange(ctx, start, end)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for categoryID, amount := range moneytree.SpendingByCategory(transactions) {
+//		fmt.Printf("%d: %v\n", categoryID, amount)
+//	}
+func SpendingByCategory(txs []PersonalAccountTransaction) map[int64]float64 {
+	spending := make(map[int64]float64)
+	for _, tx := range txs {
+		if tx.Amount >= 0 {
+			continue
+		}
+		spending[tx.CategoryID] += -tx.Amount
+	}
+	return spending
+}
+
+// SpendingByCategoryName is like SpendingByCategory, but keys the result by category name,
+// resolved through names, a map from category ID to name (e.g. built from GetCategories).
+// The spending of categories missing from names is summed under the empty string key.
+// Categories that share a name are summed together.
+//
+// Example:
+//
+//	categories, err := client.GetCategories(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	names := make(map[int64]string)
+//	for _, category := range categories.Categories {
+//		names[category.ID] = category.Name
+//	}
+//	spending := moneytree.SpendingByCategoryName(transactions, names)
+func SpendingByCategoryName(txs []PersonalAccountTransaction, names map[int64]string) map[string]float64 {
+	spending := make(map[string]float64)
+	for categoryID, amount := range SpendingByCategory(txs) {
+		spending[names[categoryID]] += amount
+	}
+	return spending
+}
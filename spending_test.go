@@ -0,0 +1,47 @@
+package moneytree
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSpendingByCategory(t *testing.T) {
+	t.Parallel()
+
+	transactions := []PersonalAccountTransaction{
+		{ID: 1, Amount: -1200, CategoryID: 10},
+		{ID: 2, Amount: 300000, CategoryID: 20},
+		{ID: 3, Amount: -800, CategoryID: 10},
+		{ID: 4, Amount: -5000, CategoryID: 30},
+		{ID: 5, Amount: 0, CategoryID: 40},
+		{ID: 6, Amount: 500, CategoryID: 30},
+		{ID: 7, Amount: -250, CategoryID: 50},
+	}
+
+	t.Run("success case: expenses are summed per category ID and income is skipped", func(t *testing.T) {
+		t.Parallel()
+
+		expected := map[int64]float64{10: 2000, 30: 5000, 50: 250}
+		if got := SpendingByCategory(transactions); !reflect.DeepEqual(got, expected) {
+			t.Errorf("expected %v, got %v", expected, got)
+		}
+	})
+
+	t.Run("success case: spending is keyed by category name", func(t *testing.T) {
+		t.Parallel()
+
+		names := map[int64]string{10: "Food", 20: "Salary", 30: "Rent"}
+		expected := map[string]float64{"Food": 2000, "Rent": 5000, "": 250}
+		if got := SpendingByCategoryName(transactions, names); !reflect.DeepEqual(got, expected) {
+			t.Errorf("expected %v, got %v", expected, got)
+		}
+	})
+
+	t.Run("success case: no transactions", func(t *testing.T) {
+		t.Parallel()
+
+		if got := SpendingByCategory(nil); len(got) != 0 {
+			t.Errorf("expected empty map, got %v", got)
+		}
+	})
+}
@@ -0,0 +1,69 @@
+package moneytree
+
+import "sync/atomic"
+
+// ClientStats is a snapshot of the request counters of a Client, as returned by Client.Stats.
+// The counters start at zero when the Client is created and only increase.
+type ClientStats struct {
+	// RequestCount is the number of HTTP requests sent, including retries. Requests that could not
+	// be sent because the token could not be refreshed are not counted.
+	RequestCount int64
+	// RetryCount is the number of requests, among RequestCount, that were retries of an earlier attempt.
+	RetryCount int64
+	// ErrorCount is the number of API calls that returned an error to the caller, counted once per
+	// call however many attempts were made.
+	ErrorCount int64
+	// Status2xx, Status3xx, Status4xx and Status5xx are the number of responses received per status class.
+	// Requests that failed without a response, e.g. because of a network error, are not counted in any class.
+	Status2xx int64
+	Status3xx int64
+	Status4xx int64
+	Status5xx int64
+}
+
+// clientStats holds the request counters of a Client. Its zero value is ready to use.
+type clientStats struct {
+	requests  atomic.Int64
+	retries   atomic.Int64
+	errors    atomic.Int64
+	status2xx atomic.Int64
+	status3xx atomic.Int64
+	status4xx atomic.Int64
+	status5xx atomic.Int64
+}
+
+// recordResponse counts a response with statusCode in its status class.
+func (s *clientStats) recordResponse(statusCode int) {
+	switch statusCode / 100 {
+	case 2:
+		s.status2xx.Add(1)
+	case 3:
+		s.status3xx.Add(1)
+	case 4:
+		s.status4xx.Add(1)
+	case 5:
+		s.status5xx.Add(1)
+	}
+}
+
+// Stats returns the current values of the request counters of the Client, for quick visibility
+// into its traffic without an external metrics library. The counters are updated atomically
+// without allocating, so they are always on; use WithAfterCall to feed a metrics system instead.
+// Each counter is read atomically, but the snapshot as a whole is not: calls running concurrently
+// with Stats may be counted in some fields only.
+//
+// Example:
+//
+//	stats := client.Stats()
+//	fmt.Printf("%d requests, %d retries, %d errors\n", stats.RequestCount, stats.RetryCount, stats.ErrorCount)
+func (c *Client) Stats() ClientStats {
+	return ClientStats{
+		RequestCount: c.stats.requests.Load(),
+		RetryCount:   c.stats.retries.Load(),
+		ErrorCount:   c.stats.errors.Load(),
+		Status2xx:    c.stats.status2xx.Load(),
+		Status3xx:    c.stats.status3xx.Load(),
+		Status4xx:    c.stats.status4xx.Load(),
+		Status5xx:    c.stats.status5xx.Load(),
+	}
+}
@@ -0,0 +1,79 @@
+package moneytree
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestStats(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: requests, retries, errors and status classes are counted", func(t *testing.T) {
+		t.Parallel()
+
+		var profileHits atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/link/profile.json":
+				// The first attempt is rate limited and retried.
+				if profileHits.Add(1) == 1 {
+					w.WriteHeader(http.StatusTooManyRequests)
+					_, _ = w.Write([]byte(`{"error": "rate_limit_exceeded"}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"email": "user@example.com"}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"error": "not_found"}`))
+			}
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		if err != nil {
+			t.Fatalf("failed to parse base URL: %v", err)
+		}
+
+		client := &Client{
+			httpClient: http.DefaultClient,
+			config: &Config{
+				BaseURL: baseURL,
+			},
+			retryConfig: RetryConfig{
+				MaxRetries: 3,
+				BaseDelay:  time.Millisecond,
+				Enabled:    true,
+			},
+		}
+		setTestToken(client, "test-access-token")
+
+		if stats := client.Stats(); stats != (ClientStats{}) {
+			t.Errorf("expected zero stats, got %+v", stats)
+		}
+
+		ctx := context.Background()
+		if _, err := client.GetProfile(ctx); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if _, err := client.GetCategory(ctx, 1); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+
+		expected := ClientStats{
+			RequestCount: 3,
+			RetryCount:   1,
+			ErrorCount:   1,
+			Status2xx:    1,
+			Status4xx:    2,
+		}
+		if stats := client.Stats(); stats != expected {
+			t.Errorf("expected %+v, got %+v", expected, stats)
+		}
+	})
+}
@@ -0,0 +1,54 @@
+package moneytree
+
+// AccountSubtype is the account_subtype of a corporate or investment account.
+// The API may introduce new subtypes at any time: a value this package does not know is decoded
+// and encoded again as is, so use IsKnown to detect it rather than comparing with the constants only.
+type AccountSubtype string
+
+// The account subtypes documented by the API.
+const (
+	AccountSubtypeSavings                    AccountSubtype = "savings"
+	AccountSubtypeChecking                   AccountSubtype = "checking"
+	AccountSubtypeChochiku                   AccountSubtype = "chochiku"
+	AccountSubtypeTermDeposit                AccountSubtype = "term_deposit"
+	AccountSubtypeTermDepositBuilder         AccountSubtype = "term_deposit_builder"
+	AccountSubtypeTermDepositShikumi         AccountSubtype = "term_deposit_shikumi"
+	AccountSubtypeZaikei                     AccountSubtype = "zaikei"
+	AccountSubtypeCardLoan                   AccountSubtype = "card_loan"
+	AccountSubtypeDebitCard                  AccountSubtype = "debit_card"
+	AccountSubtypeTaxPaymentReserveDeposit   AccountSubtype = "tax_payment_reserve_deposit"
+	AccountSubtypeCreditCard                 AccountSubtype = "credit_card"
+	AccountSubtypeLoanInstallment            AccountSubtype = "loan_installment"
+	AccountSubtypeAssetManagement            AccountSubtype = "asset_management"
+	AccountSubtypeHomeLoan                   AccountSubtype = "home_loan"
+	AccountSubtypeStoredValue                AccountSubtype = "stored_value"
+	AccountSubtypeBrokerage                  AccountSubtype = "brokerage"
+	AccountSubtypeBrokerageCash              AccountSubtype = "brokerage_cash"
+	AccountSubtypePensionCash                AccountSubtype = "pension_cash"
+	AccountSubtypeDefinedContributionPension AccountSubtype = "defined_contribution_pension"
+	AccountSubtypeTermLife                   AccountSubtype = "term_life"
+	AccountSubtypeWholeLife                  AccountSubtype = "whole_life"
+)
+
+// IsKnown reports whether s is one of the subtypes documented by the API, i.e. one of the
+// AccountSubtype constants. It returns false for the subtypes introduced after this package.
+//
+// Example:
+//
+//	if !account.AccountSubtype.IsKnown() {
+//		log.Printf("unknown account subtype: %s", account.AccountSubtype)
+//	}
+func (s AccountSubtype) IsKnown() bool {
+	switch s {
+	case AccountSubtypeSavings, AccountSubtypeChecking, AccountSubtypeChochiku, AccountSubtypeTermDeposit,
+		AccountSubtypeTermDepositBuilder, AccountSubtypeTermDepositShikumi, AccountSubtypeZaikei,
+		AccountSubtypeCardLoan, AccountSubtypeDebitCard, AccountSubtypeTaxPaymentReserveDeposit,
+		AccountSubtypeCreditCard, AccountSubtypeLoanInstallment, AccountSubtypeAssetManagement,
+		AccountSubtypeHomeLoan, AccountSubtypeStoredValue, AccountSubtypeBrokerage, AccountSubtypeBrokerageCash,
+		AccountSubtypePensionCash, AccountSubtypeDefinedContributionPension, AccountSubtypeTermLife,
+		AccountSubtypeWholeLife:
+		return true
+	default:
+		return false
+	}
+}
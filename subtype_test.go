@@ -0,0 +1,65 @@
+package moneytree
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAccountSubtype(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success case: a documented subtype is known", func(t *testing.T) {
+		t.Parallel()
+
+		var account CorporateAccount
+		if err := json.Unmarshal([]byte(`{"account_subtype": "credit_card"}`), &account); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if account.AccountSubtype != AccountSubtypeCreditCard {
+			t.Errorf("expected %s, got %s", AccountSubtypeCreditCard, account.AccountSubtype)
+		}
+		if !account.AccountSubtype.IsKnown() {
+			t.Error("expected the subtype to be known")
+		}
+	})
+
+	t.Run("success case: an unknown subtype is decoded and round-trips", func(t *testing.T) {
+		t.Parallel()
+
+		var account InvestmentAccount
+		if err := json.Unmarshal([]byte(`{"account_subtype": "crypto_wallet"}`), &account); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if account.AccountSubtype.IsKnown() {
+			t.Error("expected the subtype to be unknown")
+		}
+		data, err := json.Marshal(account.AccountSubtype)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if string(data) != `"crypto_wallet"` {
+			t.Errorf("expected \"crypto_wallet\", got %s", data)
+		}
+	})
+
+	t.Run("success case: every documented subtype is classified", func(t *testing.T) {
+		t.Parallel()
+
+		for _, subtype := range []AccountSubtype{
+			AccountSubtypeSavings, AccountSubtypeChecking, AccountSubtypeChochiku, AccountSubtypeTermDeposit,
+			AccountSubtypeTermDepositBuilder, AccountSubtypeTermDepositShikumi, AccountSubtypeZaikei,
+			AccountSubtypeCardLoan, AccountSubtypeDebitCard, AccountSubtypeTaxPaymentReserveDeposit,
+			AccountSubtypeCreditCard, AccountSubtypeLoanInstallment, AccountSubtypeAssetManagement,
+			AccountSubtypeHomeLoan, AccountSubtypeStoredValue, AccountSubtypeBrokerage, AccountSubtypeBrokerageCash,
+			AccountSubtypePensionCash, AccountSubtypeDefinedContributionPension, AccountSubtypeTermLife,
+			AccountSubtypeWholeLife,
+		} {
+			if !subtype.IsKnown() {
+				t.Errorf("expected %s to be known", subtype)
+			}
+			if DefaultSubtypeClassifier(string(subtype)) == AccountCategoryUnknown {
+				t.Errorf("expected %s to be classified", subtype)
+			}
+		}
+	})
+}
@@ -0,0 +1,48 @@
+package moneytree
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// SupportedLocales returns the locale values accepted by the locale options,
+// such as WithLocale. Option values outside this set are rejected
+// unless WithSkipClientValidation is set.
+func SupportedLocales() []string {
+	return []string{"en", "ja"}
+}
+
+// SupportedSortBy returns the sort order values accepted by the sort_by options,
+// such as WithSortByForTransactions. Option values outside this set are rejected
+// unless WithSkipClientValidation is set.
+func SupportedSortBy() []string {
+	return []string{"asc", "desc"}
+}
+
+// SupportedTransactionSortKeys returns the sort key values documented for the sort_key options
+// of the transaction endpoints, such as WithSortKeyForTransactions: "id" (the default) and "date".
+// Option values outside this set are rejected unless WithSkipClientValidation is set.
+func SupportedTransactionSortKeys() []string {
+	return []string{"id", "date"}
+}
+
+// SupportedBalanceSortKeys returns the sort key values documented for the sort_key option
+// of the balance endpoints, WithSortKeyForCorporateBalances: "id" (the default) and "date".
+// Option values outside this set are rejected unless WithSkipClientValidation is set.
+func SupportedBalanceSortKeys() []string {
+	return []string{"id", "date"}
+}
+
+// validateSortKey returns an error if sortKey is set and is not one of supported.
+func validateSortKey(sortKey *string, supported []string) error {
+	if sortKey != nil && !isSupported(*sortKey, supported) {
+		return fmt.Errorf("sort_key must be one of %s, got: %s", strings.Join(supported, ", "), *sortKey)
+	}
+	return nil
+}
+
+// isSupported reports whether value is one of supported.
+func isSupported(value string, supported []string) bool {
+	return slices.Contains(supported, value)
+}
@@ -0,0 +1,122 @@
+package moneytree
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSupportedValues(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"categories": [], "transactions": []}`))
+	}))
+	t.Cleanup(server.Close)
+
+	baseURL, err := url.Parse(server.URL + "/")
+	if err != nil {
+		t.Fatalf("failed to parse base URL: %v", err)
+	}
+
+	client := &Client{
+		httpClient: http.DefaultClient,
+		config: &Config{
+			BaseURL: baseURL,
+		},
+	}
+	setTestToken(client, "test-access-token")
+
+	t.Run("success case: every supported locale is accepted and others are rejected", func(t *testing.T) {
+		t.Parallel()
+
+		if expected := []string{"en", "ja"}; !reflect.DeepEqual(SupportedLocales(), expected) {
+			t.Errorf("expected %v, got %v", expected, SupportedLocales())
+		}
+		for _, locale := range SupportedLocales() {
+			if _, err := client.GetCategories(context.Background(), WithLocale(locale)); err != nil {
+				t.Errorf("expected locale %s to be accepted, got %v", locale, err)
+			}
+		}
+		if _, err := client.GetCategories(context.Background(), WithLocale("fr")); err == nil {
+			t.Error("expected an unsupported locale to be rejected")
+		}
+	})
+
+	t.Run("success case: every supported sort order is accepted and others are rejected", func(t *testing.T) {
+		t.Parallel()
+
+		if expected := []string{"asc", "desc"}; !reflect.DeepEqual(SupportedSortBy(), expected) {
+			t.Errorf("expected %v, got %v", expected, SupportedSortBy())
+		}
+		for _, sortBy := range SupportedSortBy() {
+			if _, err := client.GetPersonalAccountTransactions(context.Background(), "account_key_123", WithSortByForTransactions(sortBy)); err != nil {
+				t.Errorf("expected sort_by %s to be accepted, got %v", sortBy, err)
+			}
+		}
+		if _, err := client.GetPersonalAccountTransactions(context.Background(), "account_key_123", WithSortByForTransactions("ascending")); err == nil {
+			t.Error("expected an unsupported sort_by to be rejected")
+		}
+	})
+
+	t.Run("success case: every supported sort key is accepted and others are rejected", func(t *testing.T) {
+		t.Parallel()
+
+		if expected := []string{"id", "date"}; !reflect.DeepEqual(SupportedTransactionSortKeys(), expected) {
+			t.Errorf("expected %v, got %v", expected, SupportedTransactionSortKeys())
+		}
+		if expected := []string{"id", "date"}; !reflect.DeepEqual(SupportedBalanceSortKeys(), expected) {
+			t.Errorf("expected %v, got %v", expected, SupportedBalanceSortKeys())
+		}
+
+		ctx := context.Background()
+		calls := map[string]func(sortKey string) error{
+			"GetPersonalAccountTransactions": func(sortKey string) error {
+				_, err := client.GetPersonalAccountTransactions(ctx, "account_key_123", WithSortKeyForTransactions(sortKey))
+				return err
+			},
+			"GetCorporateAccountTransactions": func(sortKey string) error {
+				_, err := client.GetCorporateAccountTransactions(ctx, "account_key_123", WithSortKeyForCorporateTransactions(sortKey))
+				return err
+			},
+			"GetInvestmentAccountTransactions": func(sortKey string) error {
+				_, err := client.GetInvestmentAccountTransactions(ctx, "account_key_123", WithSortKeyForInvestmentTransactions(sortKey))
+				return err
+			},
+			"GetPointAccountTransactions": func(sortKey string) error {
+				_, err := client.GetPointAccountTransactions(ctx, 123, WithSortKeyForPointAccountTransactions(sortKey))
+				return err
+			},
+			"GetCorporateAccountBalances": func(sortKey string) error {
+				_, err := client.GetCorporateAccountBalances(ctx, "account_key_123", WithSortKeyForCorporateBalances(sortKey))
+				return err
+			},
+		}
+		for name, call := range calls {
+			for _, sortKey := range SupportedTransactionSortKeys() {
+				if err := call(sortKey); err != nil {
+					t.Errorf("%s: expected sort_key %s to be accepted, got %v", name, sortKey, err)
+				}
+			}
+			err := call("amount")
+			if err == nil || !strings.Contains(err.Error(), "sort_key must be one of id, date") {
+				t.Errorf("%s: expected an unsupported sort_key to be rejected, got %v", name, err)
+			}
+		}
+	})
+
+	t.Run("success case: returned slices can be modified by the caller", func(t *testing.T) {
+		t.Parallel()
+
+		locales := SupportedLocales()
+		locales[0] = "fr"
+		if SupportedLocales()[0] != "en" {
+			t.Error("expected a fresh slice on every call")
+		}
+	})
+}
@@ -0,0 +1,82 @@
+package moneytree
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// transferDateTolerance is the largest difference between the dates of the two sides of a transfer
+// matched by FindTransferPairs. It covers a transfer that reaches the other bank over a weekend.
+const transferDateTolerance = 3 * 24 * time.Hour
+
+// FindTransferPairs finds the transfers between the guest's own accounts among txs, which appear as
+// two offsetting transactions, so that reports can net them out instead of counting them as spending
+// and income. Each pair holds the outflow (negative amount) first and the inflow second.
+//
+// The heuristic pairs an outflow with an inflow of exactly the opposite amount in another account
+// whose date is at most 3 days apart. When several inflows qualify, the one closest in date is chosen,
+// then the one with the lowest ID. Outflows are matched in date order, and each transaction belongs to
+// at most one pair. Transactions with a zero amount or a date that cannot be parsed are ignored.
+//
+// It has limits: descriptions are not compared, so two unrelated transactions of the same amount a few
+// days apart, such as a card payment and a refund elsewhere, are reported as a transfer; a transfer whose
+// fee is deducted from one side, or that crosses currencies, is not matched; and only transfers whose
+// both sides are in txs can be found. Review the pairs before hiding transactions from a user.
+//
+// Example:
+//
+//	transactions, err := client.GetTransactionsByDateRange(ctx, start, end)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, pair := range moneytree.FindTransferPairs(transactions) {
+//		fmt.Printf("Transfer of %v from account %d to account %d\n", pair[1].Amount, pair[0].AccountID, pair[1].AccountID)
+//	}
+func FindTransferPairs(txs []PersonalAccountTransaction) [][2]PersonalAccountTransaction {
+	type dated struct {
+		tx   PersonalAccountTransaction
+		date time.Time
+	}
+	var outflows, inflows []dated
+	for _, tx := range txs {
+		date, err := tx.ParsedDate()
+		if err != nil || date.IsZero() {
+			continue
+		}
+		switch {
+		case tx.Amount < 0:
+			outflows = append(outflows, dated{tx: tx, date: date})
+		case tx.Amount > 0:
+			inflows = append(inflows, dated{tx: tx, date: date})
+		}
+	}
+	byDate := func(a, b dated) int {
+		return cmp.Or(a.date.Compare(b.date), cmp.Compare(a.tx.ID, b.tx.ID))
+	}
+	slices.SortStableFunc(outflows, byDate)
+
+	var pairs [][2]PersonalAccountTransaction
+	matched := make([]bool, len(inflows))
+	for _, out := range outflows {
+		best := -1
+		var bestGap time.Duration
+		for i, in := range inflows {
+			if matched[i] || in.tx.AccountID == out.tx.AccountID || in.tx.Amount != -out.tx.Amount {
+				continue
+			}
+			gap := in.date.Sub(out.date).Abs()
+			if gap > transferDateTolerance {
+				continue
+			}
+			if best < 0 || gap < bestGap || (gap == bestGap && in.tx.ID < inflows[best].tx.ID) {
+				best, bestGap = i, gap
+			}
+		}
+		if best >= 0 {
+			matched[best] = true
+			pairs = append(pairs, [2]PersonalAccountTransaction{out.tx, inflows[best].tx})
+		}
+	}
+	return pairs
+}
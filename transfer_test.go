@@ -0,0 +1,79 @@
+package moneytree
+
+import "testing"
+
+func TestFindTransferPairs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		txs      []PersonalAccountTransaction
+		expected [][2]int64
+	}{
+		{
+			name: "success case: a transfer between two accounts is paired",
+			txs: []PersonalAccountTransaction{
+				{ID: 1, AccountID: 10, Amount: 30000, Date: "2023-03-02T00:00:00Z"},
+				{ID: 2, AccountID: 20, Amount: -1200, Date: "2023-03-01T00:00:00Z"},
+				{ID: 3, AccountID: 20, Amount: -30000, Date: "2023-03-01T00:00:00Z"},
+			},
+			expected: [][2]int64{{3, 1}},
+		},
+		{
+			name: "success case: the inflow closest in date is chosen",
+			txs: []PersonalAccountTransaction{
+				{ID: 1, AccountID: 20, Amount: -5000, Date: "2023-03-01T00:00:00Z"},
+				{ID: 2, AccountID: 10, Amount: 5000, Date: "2023-03-03T00:00:00Z"},
+				{ID: 3, AccountID: 30, Amount: 5000, Date: "2023-03-01T00:00:00Z"},
+			},
+			expected: [][2]int64{{1, 3}},
+		},
+		{
+			name: "success case: a near miss is not paired",
+			txs: []PersonalAccountTransaction{
+				// Too far apart.
+				{ID: 1, AccountID: 20, Amount: -30000, Date: "2023-03-01T00:00:00Z"},
+				{ID: 2, AccountID: 10, Amount: 30000, Date: "2023-03-05T00:00:00Z"},
+				// A fee was deducted from the inflow.
+				{ID: 3, AccountID: 20, Amount: -10000, Date: "2023-03-10T00:00:00Z"},
+				{ID: 4, AccountID: 10, Amount: 9780, Date: "2023-03-10T00:00:00Z"},
+				// A refund in the same account.
+				{ID: 5, AccountID: 20, Amount: -800, Date: "2023-03-12T00:00:00Z"},
+				{ID: 6, AccountID: 20, Amount: 800, Date: "2023-03-12T00:00:00Z"},
+			},
+			expected: nil,
+		},
+		{
+			name: "success case: each transaction belongs to one pair at most",
+			txs: []PersonalAccountTransaction{
+				{ID: 1, AccountID: 20, Amount: -1000, Date: "2023-03-01T00:00:00Z"},
+				{ID: 2, AccountID: 20, Amount: -1000, Date: "2023-03-02T00:00:00Z"},
+				{ID: 3, AccountID: 10, Amount: 1000, Date: "2023-03-01T00:00:00Z"},
+			},
+			expected: [][2]int64{{1, 3}},
+		},
+		{
+			name: "success case: a malformed date is ignored",
+			txs: []PersonalAccountTransaction{
+				{ID: 1, AccountID: 20, Amount: -1000, Date: "not a date"},
+				{ID: 2, AccountID: 10, Amount: 1000, Date: "2023-03-01T00:00:00Z"},
+			},
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pairs := FindTransferPairs(tt.txs)
+			if len(pairs) != len(tt.expected) {
+				t.Fatalf("expected %d pairs, got %d: %+v", len(tt.expected), len(pairs), pairs)
+			}
+			for i, pair := range pairs {
+				if pair[0].ID != tt.expected[i][0] || pair[1].ID != tt.expected[i][1] {
+					t.Errorf("expected pair %d to be %v, got [%d %d]", i, tt.expected[i], pair[0].ID, pair[1].ID)
+				}
+			}
+		})
+	}
+}